@@ -1,6 +1,16 @@
 package linodego
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
 
 // Account associated with the token in use.
 type Account struct {
@@ -20,6 +30,27 @@ type Account struct {
 	Phone             string      `json:"phone"`
 	CreditCard        *CreditCard `json:"credit_card"`
 	EUUID             string      `json:"euuid"`
+	ActiveSince       *time.Time  `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *Account) UnmarshalJSON(b []byte) error {
+	type Mask Account
+
+	p := struct {
+		*Mask
+		ActiveSince *parseabletime.ParseableTime `json:"active_since"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.ActiveSince = (*time.Time)(p.ActiveSince)
+
+	return nil
 }
 
 // CreditCard information associated with the Account.
@@ -39,3 +70,80 @@ func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
 
 	return r.Result().(*Account), nil
 }
+
+// ErrAccountNotActivated is returned by CheckAccountReady when the account
+// has not yet been activated and cannot provision resources.
+var ErrAccountNotActivated = errors.New("account is not yet activated")
+
+// accountNotActivatedReason is the API error reason returned for requests made by
+// accounts that have not yet been activated, e.g.
+// "Your account must be activated before you can use this endpoint"
+const accountNotActivatedReason = "your account must be activated"
+
+// accountNotActivatedError wraps the error returned for an account that has not yet
+// been activated, if any, so that both it and ErrAccountNotActivated can be matched
+type accountNotActivatedError struct {
+	err error
+}
+
+func (e *accountNotActivatedError) Error() string {
+	if e.err == nil {
+		return ErrAccountNotActivated.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", ErrAccountNotActivated, e.err)
+}
+
+func (e *accountNotActivatedError) Unwrap() error {
+	return e.err
+}
+
+func (e *accountNotActivatedError) Is(target error) bool {
+	return target == ErrAccountNotActivated
+}
+
+// IsAccountNotActivated returns true if err indicates that the account
+// has not yet been activated.
+func IsAccountNotActivated(err error) bool {
+	if errors.Is(err, ErrAccountNotActivated) {
+		return true
+	}
+
+	linodeErr, ok := asError(err)
+	if !ok || linodeErr.Code != http.StatusForbidden {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(linodeErr.Message), accountNotActivatedReason)
+}
+
+// CheckAccountReady checks that the account is able to provision resources.
+// The account must have been activated, and be able to list its Instances,
+// which the API rejects for accounts that have not been activated.
+// An error wrapping ErrAccountNotActivated, and the API error if there is one,
+// is returned if the account has not yet been activated.
+func (c *Client) CheckAccountReady(ctx context.Context) error {
+	account, err := c.GetAccount(ctx)
+	if err != nil {
+		if IsAccountNotActivated(err) {
+			return &accountNotActivatedError{err: err}
+		}
+
+		return err
+	}
+
+	if account.ActiveSince == nil {
+		return &accountNotActivatedError{}
+	}
+
+	_, err = c.ListInstances(ctx, &ListOptions{PageOptions: &PageOptions{Page: 1}, PageSize: minPageSize})
+	if err == nil {
+		return nil
+	}
+
+	if IsAccountNotActivated(err) {
+		return &accountNotActivatedError{err: err}
+	}
+
+	return err
+}
@@ -0,0 +1,176 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// BetaProgram represents a beta program that the account can be enrolled in
+type BetaProgram struct {
+	ID          string     `json:"id"`
+	Label       string     `json:"label"`
+	Description string     `json:"description"`
+	Started     *time.Time `json:"-"`
+	Ended       *time.Time `json:"-"`
+
+	// GreenlightOnly is true if the beta program is only available to Greenlight members
+	GreenlightOnly bool `json:"greenlight_only"`
+}
+
+// AccountBetaProgram represents a beta program the account is enrolled in
+type AccountBetaProgram struct {
+	ID          string     `json:"id"`
+	Label       string     `json:"label"`
+	Description string     `json:"description"`
+	Started     *time.Time `json:"-"`
+	Ended       *time.Time `json:"-"`
+	Enrolled    *time.Time `json:"-"`
+}
+
+// AccountBetaProgramCreateOpts fields are those accepted by JoinBetaProgram
+type AccountBetaProgramCreateOpts struct {
+	ID string `json:"id"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *BetaProgram) UnmarshalJSON(data []byte) error {
+	type Mask BetaProgram
+
+	p := struct {
+		*Mask
+		Started *parseabletime.ParseableTime `json:"started"`
+		Ended   *parseabletime.ParseableTime `json:"ended"`
+	}{
+		Mask: (*Mask)(b),
+	}
+
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+
+	b.Started = (*time.Time)(p.Started)
+	b.Ended = (*time.Time)(p.Ended)
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *AccountBetaProgram) UnmarshalJSON(data []byte) error {
+	type Mask AccountBetaProgram
+
+	p := struct {
+		*Mask
+		Started  *parseabletime.ParseableTime `json:"started"`
+		Ended    *parseabletime.ParseableTime `json:"ended"`
+		Enrolled *parseabletime.ParseableTime `json:"enrolled"`
+	}{
+		Mask: (*Mask)(b),
+	}
+
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+
+	b.Started = (*time.Time)(p.Started)
+	b.Ended = (*time.Time)(p.Ended)
+	b.Enrolled = (*time.Time)(p.Enrolled)
+
+	return nil
+}
+
+// ListBetaPrograms lists the active beta programs
+func (c *Client) ListBetaPrograms(ctx context.Context, opts *ListOptions) ([]BetaProgram, error) {
+	return listPaginated[BetaProgram](ctx, c, "betas", opts)
+}
+
+// ListAccountBetaPrograms lists the beta programs the account is enrolled in
+func (c *Client) ListAccountBetaPrograms(ctx context.Context, opts *ListOptions) ([]AccountBetaProgram, error) {
+	return listPaginated[AccountBetaProgram](ctx, c, "account/betas", opts)
+}
+
+// JoinBetaProgram enrolls the account in the beta program with the given ID
+func (c *Client) JoinBetaProgram(ctx context.Context, opts AccountBetaProgramCreateOpts) (*AccountBetaProgram, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := "account/betas"
+	req := c.R(ctx).SetResult(&AccountBetaProgram{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*AccountBetaProgram), nil
+}
+
+// featureUnavailableReasons are fragments of the API error reasons returned when
+// a feature is not enabled for the account, e.g. because it is in beta.
+// They only match reasons about the account, so that errors about resources
+// that merely mention a beta are not mistaken for feature gates.
+var featureUnavailableReasons = []string{
+	"not enabled for this account",
+	"not available for this account",
+	"enroll in the",
+	"enrolled in the",
+}
+
+// IsFeatureUnavailable returns true if err indicates that the requested feature
+// is not enabled for the account. See GetBetaProgramForError to find the beta
+// program that may need to be joined.
+func IsFeatureUnavailable(err error) bool {
+	linodeErr, ok := asError(err)
+	if !ok {
+		return false
+	}
+
+	if linodeErr.Code != http.StatusBadRequest && linodeErr.Code != http.StatusForbidden {
+		return false
+	}
+
+	message := strings.ToLower(linodeErr.Message)
+	for _, reason := range featureUnavailableReasons {
+		if strings.Contains(message, reason) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetBetaProgramForError returns the active beta program that the feature reported
+// by err as unavailable belongs to, matched by the beta program's ID or label appearing
+// in the error. Nil is returned if err is not a feature gate error or no beta matches.
+func (c *Client) GetBetaProgramForError(ctx context.Context, err error) (*BetaProgram, error) {
+	if !IsFeatureUnavailable(err) {
+		return nil, nil
+	}
+
+	linodeErr, _ := asError(err)
+	message := strings.ToLower(linodeErr.Message)
+
+	betas, listErr := c.ListBetaPrograms(ctx, nil)
+	if listErr != nil {
+		return nil, fmt.Errorf("failed to list beta programs: %w", listErr)
+	}
+
+	for i, beta := range betas {
+		for _, name := range []string{
+			strings.TrimSuffix(strings.ToLower(beta.ID), "_beta"),
+			strings.TrimSuffix(strings.ToLower(beta.Label), " beta"),
+		} {
+			if name != "" && strings.Contains(message, name) {
+				return &betas[i], nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,73 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestIsFeatureUnavailable(t *testing.T) {
+	if !IsFeatureUnavailable(&Error{Code: http.StatusForbidden, Message: "VPCs are not enabled for this account"}) {
+		t.Error("expected error to be classified as a feature gate")
+	}
+
+	if IsFeatureUnavailable(&Error{Code: http.StatusForbidden, Message: "Unauthorized"}) {
+		t.Error("expected a generic 403 not to be classified as a feature gate")
+	}
+
+	if !IsFeatureUnavailable(&Error{Code: http.StatusBadRequest, Message: "You must be enrolled in the VPC beta to use this feature"}) {
+		t.Error("expected an enrollment error to be classified as a feature gate")
+	}
+
+	for _, err := range []*Error{
+		{Code: http.StatusInternalServerError, Message: "beta is down"},
+		{Code: http.StatusNotFound, Message: "Not found"},
+		{Code: http.StatusNotFound, Message: "Beta program not found"},
+		{Code: http.StatusNotFound, Message: "VPCs are not enabled for this account"},
+		{Code: http.StatusBadRequest, Message: "[id] Invalid beta program"},
+		{Code: http.StatusForbidden, Message: "You do not have permission to access this beta resource"},
+		{Code: http.StatusBadRequest, Message: "Backups have not been enabled for this Linode"},
+	} {
+		if IsFeatureUnavailable(err) {
+			t.Errorf("expected %d %q not to be classified as a feature gate", err.Code, err.Message)
+		}
+	}
+}
+
+func TestClient_GetBetaProgramForError(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/betas": {http.StatusOK, `{"data": [
+			{"id": "metadata_beta", "label": "Metadata Beta"},
+			{"id": "vpc_beta", "label": "VPC Beta"}
+		], "page": 1, "pages": 1, "results": 2}`},
+	})
+	defer ts.Close()
+
+	beta, err := client.GetBetaProgramForError(
+		context.Background(),
+		&Error{Code: http.StatusForbidden, Message: "VPCs are not enabled for this account"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if beta == nil || beta.ID != "vpc_beta" {
+		t.Errorf("expected the VPC beta, got %+v", beta)
+	}
+}
+
+func TestClient_GetBetaProgramForError_notFeatureGate(t *testing.T) {
+	client := NewClient(nil)
+
+	beta, err := client.GetBetaProgramForError(
+		context.Background(),
+		&Error{Code: http.StatusNotFound, Message: "VPC beta resource not found"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if beta != nil {
+		t.Errorf("expected no beta for a not found error, got %+v", beta)
+	}
+}
@@ -158,6 +158,7 @@ type EntityType string
 // EntityType contants are the entities an Event can be related to.
 const (
 	EntityLinode       EntityType = "linode"
+	EntityLinodeConfig EntityType = "linode_config"
 	EntityDisk         EntityType = "disk"
 	EntityDatabase     EntityType = "database"
 	EntityDomain       EntityType = "domain"
@@ -245,6 +246,79 @@ func (c *Client) ListEvents(ctx context.Context, opts *ListOptions) ([]Event, er
 	return response.Data, nil
 }
 
+// ListEventsBetween lists the Events created between from and to, inclusive, fetching every
+// page of the range unless opts requests a specific page. Any filter in opts is combined with
+// the time window. Events are ordered from oldest to newest unless opts orders them otherwise.
+func (c *Client) ListEventsBetween(ctx context.Context, from, to time.Time, opts *ListOptions) ([]Event, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("the end of the time window (%s) is before its start (%s)", to, from)
+	}
+
+	listOpts, err := withFilterAnd(opts,
+		map[string]any{"created": map[string]any{string(Gte): parseabletime.Format(from)}},
+		map[string]any{"created": map[string]any{string(Lte): parseabletime.Format(to)}},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// withFilterAnd always produces a JSON object, and preserves any ordering from opts
+	var filter map[string]any
+	if err := json.Unmarshal([]byte(listOpts.Filter), &filter); err != nil {
+		return nil, err
+	}
+
+	if _, ok := filter["+order_by"]; !ok {
+		filter["+order_by"] = "created"
+		filter["+order"] = Ascending
+
+		filterBytes, err := json.Marshal(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		listOpts.Filter = string(filterBytes)
+	}
+
+	return c.ListEvents(ctx, listOpts)
+}
+
+// HasPendingOperation returns true and the most recent pending Event if an operation on the
+// entity is scheduled or has started but not yet finished or failed. This can be used to check
+// whether the entity is busy before issuing another operation on it, rather than handling the
+// error (see IsLinodeBusy). Only the 100 most recent Events of the entity are considered.
+func (c *Client) HasPendingOperation(ctx context.Context, entityID int, entityType EntityType) (bool, *Event, error) {
+	f := Filter{
+		OrderBy: "created",
+		Order:   Descending,
+	}
+	f.AddField(Eq, "entity.id", entityID)
+	f.AddField(Eq, "entity.type", entityType)
+
+	filter, err := f.MarshalJSON()
+	if err != nil {
+		return false, nil, err
+	}
+
+	events, err := c.ListEvents(ctx, &ListOptions{
+		Filter:      string(filter),
+		PageSize:    100,
+		PageOptions: &PageOptions{Page: 1},
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	for _, event := range events {
+		if event.Status == EventScheduled || event.Status == EventStarted {
+			event := event
+			return true, &event, nil
+		}
+	}
+
+	return false, nil, nil
+}
+
 // GetEvent gets the Event with the Event ID
 func (c *Client) GetEvent(ctx context.Context, eventID int) (*Event, error) {
 	req := c.R(ctx).SetResult(&Event{})
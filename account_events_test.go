@@ -0,0 +1,183 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEventPoller_WaitForFinishedWithProgress(t *testing.T) {
+	polls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/account/events":
+			rw.Write([]byte(`{"data": [{
+				"id": 5,
+				"action": "linode_clone",
+				"status": "started",
+				"percent_complete": 0,
+				"entity": {"id": 123, "type": "linode", "label": "source"},
+				"secondary_entity": {"id": 456, "type": "linode", "label": "target"}
+			}], "page": 1, "pages": 1, "results": 1}`))
+		case "/v4/account/events/5":
+			polls++
+			if polls == 1 {
+				rw.Write([]byte(`{"id": 5, "status": "started", "percent_complete": 50, "time_remaining": "00:01:00", "secondary_entity": {"id": 456, "type": "linode"}}`))
+				return
+			}
+
+			rw.Write([]byte(`{"id": 5, "status": "finished", "percent_complete": 100, "secondary_entity": {"id": 456, "type": "linode"}}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	poller, err := client.NewEventPollerWithoutEntity(EntityLinode, ActionLinodeClone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	poller.EntityID = 123
+
+	var progress []int
+
+	event, err := poller.WaitForFinishedWithProgress(context.Background(), 10, func(current *Event) {
+		progress = append(progress, current.PercentComplete)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(progress) != 2 || progress[0] != 50 || progress[1] != 100 {
+		t.Errorf("expected progress of 50 and 100, got %v", progress)
+	}
+
+	if event.SecondaryEntity == nil || event.SecondaryEntity.ID != float64(456) || event.SecondaryEntity.Type != EntityLinode {
+		t.Errorf("expected the clone target as the secondary entity, got %+v", event.SecondaryEntity)
+	}
+}
+
+func TestClient_ListEventsBetween(t *testing.T) {
+	var filters []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		filters = append(filters, r.Header.Get("X-Filter"))
+
+		if r.URL.Query().Get("page") == "2" {
+			rw.Write([]byte(`{"data": [{"id": 3}], "page": 2, "pages": 2, "results": 3}`))
+			return
+		}
+
+		rw.Write([]byte(`{"data": [{"id": 1}, {"id": 2}], "page": 1, "pages": 2, "results": 3}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 31, 23, 59, 59, 0, time.UTC)
+
+	events, err := client.ListEventsBetween(context.Background(), from, to, &ListOptions{Filter: `{"entity.type": "linode"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) != 3 {
+		t.Errorf("expected every page of the window to be fetched, got %d events", len(events))
+	}
+
+	var filter map[string]any
+	if err := json.Unmarshal([]byte(filters[0]), &filter); err != nil {
+		t.Fatal(err)
+	}
+
+	and, _ := filter["+and"].([]any)
+	if len(and) != 3 || filter["+order_by"] != "created" || filter["+order"] != "asc" {
+		t.Fatalf("unexpected filter: %s", filters[0])
+	}
+
+	expected := []string{`{"entity.type":"linode"}`, `{"created":{"+gte":"2024-01-01T00:00:00"}}`, `{"created":{"+lte":"2024-01-31T23:59:59"}}`}
+	for i, node := range and {
+		if b, _ := json.Marshal(node); string(b) != expected[i] {
+			t.Errorf("expected filter node %s, got %s", expected[i], b)
+		}
+	}
+
+	if _, err := client.ListEventsBetween(context.Background(), to, from, nil); err == nil {
+		t.Error("expected an error for a reversed window")
+	}
+}
+
+func TestClient_HasPendingOperation(t *testing.T) {
+	tests := []struct {
+		name     string
+		events   string
+		pending  bool
+		expected int
+	}{
+		{
+			name:     "started",
+			events:   `{"data": [{"id": 3, "status": "finished"}, {"id": 2, "status": "started"}, {"id": 1, "status": "scheduled"}], "page": 1, "pages": 1, "results": 3}`,
+			pending:  true,
+			expected: 2,
+		},
+		{
+			name:    "settled",
+			events:  `{"data": [{"id": 2, "status": "failed"}, {"id": 1, "status": "notification"}], "page": 1, "pages": 1, "results": 2}`,
+			pending: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, client := createTestServer(http.MethodGet, "/v4/account/events", "application/json", test.events, http.StatusOK)
+			defer ts.Close()
+
+			pending, event, err := client.HasPendingOperation(context.Background(), 123, EntityLinode)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if pending != test.pending {
+				t.Fatalf("expected pending to be %t", test.pending)
+			}
+
+			if pending && event.ID != test.expected {
+				t.Errorf("expected event %d, got %d", test.expected, event.ID)
+			}
+		})
+	}
+}
+
+func TestClient_WaitForResourceFree_timeout(t *testing.T) {
+	ts, client := createTestServer(
+		http.MethodGet, "/v4/account/events", "application/json",
+		`{"data": [{"id": 1, "action": "linode_boot", "status": "started"}], "page": 1, "pages": 1, "results": 1}`,
+		http.StatusOK,
+	)
+	defer ts.Close()
+
+	client.SetPollDelay(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := client.WaitForResourceFree(ctx, EntityLinode, 123, 60)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to time out, got %v", err)
+	}
+}
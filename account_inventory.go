@@ -0,0 +1,338 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// InventoryResource is a type of resource that can be included in an AccountInventory
+type InventoryResource string
+
+// InventoryResource constants are the resources fetched by GetAccountInventory
+const (
+	InventoryInstances            InventoryResource = "instances"
+	InventoryVolumes              InventoryResource = "volumes"
+	InventoryDomains              InventoryResource = "domains"
+	InventoryNodeBalancers        InventoryResource = "nodebalancers"
+	InventoryFirewalls            InventoryResource = "firewalls"
+	InventoryLKEClusters          InventoryResource = "lke_clusters"
+	InventoryObjectStorageBuckets InventoryResource = "object_storage_buckets"
+	InventoryDatabases            InventoryResource = "databases"
+	InventoryImages               InventoryResource = "images"
+	InventoryStackscripts         InventoryResource = "stackscripts"
+)
+
+const defaultInventoryConcurrency = 4
+
+// InventoryOptions configures GetAccountInventory
+type InventoryOptions struct {
+	// Resources are the resources to fetch, defaulting to all of them
+	Resources []InventoryResource
+
+	// Concurrency is the number of resources fetched at once, defaulting to 4
+	Concurrency int
+
+	// Since limits the inventory to resources updated after the given time, so that
+	// periodic syncs only fetch what has changed. Domains and Object Storage buckets
+	// have no updated timestamp, so they are always fully listed.
+	Since *time.Time
+}
+
+// AccountInventory holds the resources on an Account. Images and StackScripts only
+// include those owned by the Account.
+type AccountInventory struct {
+	Instances            []Instance
+	Volumes              []Volume
+	Domains              []Domain
+	NodeBalancers        []NodeBalancer
+	Firewalls            []Firewall
+	LKEClusters          []LKECluster
+	ObjectStorageBuckets []ObjectStorageBucket
+	Databases            []Database
+	Images               []Image
+	Stackscripts         []Stackscript
+}
+
+// InventoryError is returned by GetAccountInventory when some of the resources could not be fetched
+type InventoryError struct {
+	Errors map[InventoryResource]error
+}
+
+func (e *InventoryError) Error() string {
+	resources := make([]string, 0, len(e.Errors))
+	for resource := range e.Errors {
+		resources = append(resources, string(resource))
+	}
+
+	sort.Strings(resources)
+
+	messages := make([]string, len(resources))
+	for i, resource := range resources {
+		messages[i] = fmt.Sprintf("%s: %s", resource, e.Errors[InventoryResource(resource)])
+	}
+
+	return fmt.Sprintf("failed to fetch %d inventory resources: %s", len(messages), strings.Join(messages, "; "))
+}
+
+// inventoryFetchers fetch each InventoryResource into an AccountInventory.
+// Each fetcher only sets its own field, so they can be run concurrently.
+var inventoryFetchers = map[InventoryResource]func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) error{
+	InventoryInstances: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.Instances, err = c.ListInstances(ctx, opts)
+		return err
+	},
+	InventoryVolumes: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.Volumes, err = c.ListVolumes(ctx, opts)
+		return err
+	},
+	InventoryDomains: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.Domains, err = c.ListDomains(ctx, opts)
+		return err
+	},
+	InventoryNodeBalancers: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.NodeBalancers, err = c.ListNodeBalancers(ctx, opts)
+		return err
+	},
+	InventoryFirewalls: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.Firewalls, err = c.ListFirewalls(ctx, opts)
+		return err
+	},
+	InventoryLKEClusters: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.LKEClusters, err = c.ListLKEClusters(ctx, opts)
+		return err
+	},
+	InventoryObjectStorageBuckets: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.ObjectStorageBuckets, err = c.ListObjectStorageBuckets(ctx, opts)
+		return err
+	},
+	InventoryDatabases: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.Databases, err = c.ListDatabases(ctx, opts)
+		return err
+	},
+	InventoryImages: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.Images, err = c.ListImages(ctx, opts)
+		return err
+	},
+	InventoryStackscripts: func(ctx context.Context, c *Client, inventory *AccountInventory, opts *ListOptions) (err error) {
+		inventory.Stackscripts, err = c.ListStackscripts(ctx, opts)
+		return err
+	},
+}
+
+// inventoryOwnedFilters limit resources that include public ones to those owned by the Account
+var inventoryOwnedFilters = map[InventoryResource]map[string]any{
+	InventoryImages:       {"is_public": false},
+	InventoryStackscripts: {"mine": true},
+}
+
+// inventoryWithoutUpdated are the resources that can't be filtered by their updated timestamp
+var inventoryWithoutUpdated = map[InventoryResource]bool{
+	InventoryDomains:              true,
+	InventoryObjectStorageBuckets: true,
+}
+
+// inventoryListOptions returns the ListOptions used to fetch resource for an AccountInventory
+func inventoryListOptions(resource InventoryResource, since *time.Time) (*ListOptions, error) {
+	fields := make(map[string]any)
+
+	for key, value := range inventoryOwnedFilters[resource] {
+		fields[key] = value
+	}
+
+	if since != nil && !inventoryWithoutUpdated[resource] {
+		fields["updated"] = map[string]any{string(Gt): parseabletime.Format(*since)}
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return withFilterFields(nil, fields)
+}
+
+// allInventoryResources lists every InventoryResource in a stable order
+var allInventoryResources = []InventoryResource{
+	InventoryInstances,
+	InventoryVolumes,
+	InventoryDomains,
+	InventoryNodeBalancers,
+	InventoryFirewalls,
+	InventoryLKEClusters,
+	InventoryObjectStorageBuckets,
+	InventoryDatabases,
+	InventoryImages,
+	InventoryStackscripts,
+}
+
+// GetAccountInventory concurrently fetches the resources on the Account, optionally
+// limited to those updated since opts.Since.
+// If some of the resources could not be fetched, the inventory of those that were
+// is returned along with an *InventoryError describing each failure.
+func (c *Client) GetAccountInventory(ctx context.Context, opts InventoryOptions) (*AccountInventory, error) {
+	resources := opts.Resources
+	if len(resources) == 0 {
+		resources = allInventoryResources
+	}
+
+	for _, resource := range resources {
+		if _, ok := inventoryFetchers[resource]; !ok {
+			return nil, fmt.Errorf("unknown inventory resource %q", resource)
+		}
+	}
+
+	inventory := AccountInventory{}
+
+	errs := forEachInventoryResource(resources, opts.Concurrency, func(resource InventoryResource) error {
+		listOpts, err := inventoryListOptions(resource, opts.Since)
+		if err != nil {
+			return err
+		}
+
+		return inventoryFetchers[resource](ctx, c, &inventory, listOpts)
+	})
+
+	if len(errs) > 0 {
+		return &inventory, &InventoryError{Errors: errs}
+	}
+
+	return &inventory, nil
+}
+
+// minPageSize is the smallest page size accepted by the API
+const minPageSize = 25
+
+// EntityCounts holds the number of each type of resource on an Account. Images and
+// StackScripts only count those owned by the Account.
+type EntityCounts struct {
+	Instances            int
+	Volumes              int
+	Domains              int
+	NodeBalancers        int
+	Firewalls            int
+	LKEClusters          int
+	ObjectStorageBuckets int
+	Databases            int
+	Images               int
+	Stackscripts         int
+}
+
+// count returns the field of EntityCounts holding the count of resource
+func (e *EntityCounts) count(resource InventoryResource) *int {
+	switch resource {
+	case InventoryInstances:
+		return &e.Instances
+	case InventoryVolumes:
+		return &e.Volumes
+	case InventoryDomains:
+		return &e.Domains
+	case InventoryNodeBalancers:
+		return &e.NodeBalancers
+	case InventoryFirewalls:
+		return &e.Firewalls
+	case InventoryLKEClusters:
+		return &e.LKEClusters
+	case InventoryObjectStorageBuckets:
+		return &e.ObjectStorageBuckets
+	case InventoryDatabases:
+		return &e.Databases
+	case InventoryImages:
+		return &e.Images
+	case InventoryStackscripts:
+		return &e.Stackscripts
+	}
+
+	return nil
+}
+
+// GetEntityCounts concurrently counts each type of resource on the Account. Rather than
+// listing every resource, only the first page of each List endpoint is fetched, at the
+// smallest page size the API allows, and the total number of results is read from it.
+// If some of the resources could not be counted, the counts of those that were are
+// returned along with an *InventoryError describing each failure.
+func (c *Client) GetEntityCounts(ctx context.Context) (*EntityCounts, error) {
+	counts := EntityCounts{}
+
+	errs := forEachInventoryResource(allInventoryResources, defaultInventoryConcurrency, func(resource InventoryResource) error {
+		listOpts, err := inventoryListOptions(resource, nil)
+		if err != nil {
+			return err
+		}
+
+		if listOpts == nil {
+			listOpts = &ListOptions{}
+		}
+
+		listOpts.PageOptions = &PageOptions{Page: 1}
+		listOpts.PageSize = minPageSize
+
+		// The page is fetched into a throwaway inventory, only opts.Results is kept
+		if err := inventoryFetchers[resource](ctx, c, &AccountInventory{}, listOpts); err != nil {
+			return err
+		}
+
+		*counts.count(resource) = listOpts.Results
+
+		return nil
+	})
+
+	if len(errs) > 0 {
+		return &counts, &InventoryError{Errors: errs}
+	}
+
+	return &counts, nil
+}
+
+// forEachInventoryResource runs fn for each of resources, at most concurrency at once
+// (defaulting to 4), and returns the errors of those that failed. fn is called once
+// per resource even if it is listed more than once.
+func forEachInventoryResource(
+	resources []InventoryResource,
+	concurrency int,
+	fn func(resource InventoryResource) error,
+) map[InventoryResource]error {
+	if concurrency <= 0 {
+		concurrency = defaultInventoryConcurrency
+	}
+
+	var (
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		errs    = make(map[InventoryResource]error)
+		slots   = make(chan struct{}, concurrency)
+		visited = make(map[InventoryResource]bool, len(resources))
+	)
+
+	for _, resource := range resources {
+		// Skip duplicates, which would otherwise race on the same field
+		if visited[resource] {
+			continue
+		}
+
+		visited[resource] = true
+
+		wg.Add(1)
+
+		go func(resource InventoryResource) {
+			defer wg.Done()
+
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			if err := fn(resource); err != nil {
+				lock.Lock()
+				errs[resource] = err
+				lock.Unlock()
+			}
+		}(resource)
+	}
+
+	wg.Wait()
+
+	return errs
+}
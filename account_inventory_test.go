@@ -0,0 +1,128 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_GetAccountInventory(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances": {http.StatusOK, `{"data": [{"id": 1}, {"id": 2}], "page": 1, "pages": 1, "results": 2}`},
+		"/v4/volumes":          {http.StatusOK, `{"data": [{"id": 3}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/domains":          {http.StatusInternalServerError, `{"errors": [{"reason": "unavailable"}]}`},
+	})
+	defer ts.Close()
+
+	client.SetRetryCount(0)
+
+	inventory, err := client.GetAccountInventory(context.Background(), InventoryOptions{
+		Resources: []InventoryResource{InventoryInstances, InventoryVolumes, InventoryDomains},
+	})
+
+	var inventoryErr *InventoryError
+	if !errors.As(err, &inventoryErr) {
+		t.Fatalf("expected an InventoryError, got %v", err)
+	}
+
+	if len(inventoryErr.Errors) != 1 || inventoryErr.Errors[InventoryDomains] == nil {
+		t.Errorf("expected only domains to fail, got %v", inventoryErr.Errors)
+	}
+
+	if len(inventory.Instances) != 2 || len(inventory.Volumes) != 1 {
+		t.Errorf("expected the fetched resources to be returned, got %+v", inventory)
+	}
+
+	if _, err := client.GetAccountInventory(context.Background(), InventoryOptions{
+		Resources: []InventoryResource{"unknown"},
+	}); err == nil {
+		t.Error("expected an error for an unknown resource")
+	}
+}
+
+func TestInventoryListOptions(t *testing.T) {
+	since := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	opts, err := inventoryListOptions(InventoryImages, &since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var filter map[string]any
+	if err := json.Unmarshal([]byte(opts.Filter), &filter); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, _ := filter["updated"].(map[string]any)
+	if filter["is_public"] != false || updated["+gt"] != "2024-01-02T03:04:05" {
+		t.Errorf("unexpected filter: %s", opts.Filter)
+	}
+
+	if opts, _ := inventoryListOptions(InventoryDomains, &since); opts != nil {
+		t.Errorf("expected domains to be fully listed, got %s", opts.Filter)
+	}
+}
+
+func TestClient_GetEntityCounts(t *testing.T) {
+	var (
+		lock    sync.Mutex
+		queries = make(map[string]url.Values)
+		filters = make(map[string]string)
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		queries[r.URL.Path] = r.URL.Query()
+		filters[r.URL.Path] = r.Header.Get("X-Filter")
+
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances":
+			rw.Write([]byte(`{"data": [{"id": 1}], "page": 1, "pages": 120, "results": 120}`))
+		case "/v4/images":
+			rw.Write([]byte(`{"data": [{"id": "private/1"}], "page": 1, "pages": 3, "results": 3}`))
+		case "/v4/domains":
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`{"errors": [{"reason": "unavailable"}]}`))
+		default:
+			rw.Write([]byte(`{"data": [], "page": 1, "pages": 0, "results": 0}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryCount(0)
+
+	counts, err := client.GetEntityCounts(context.Background())
+
+	var inventoryErr *InventoryError
+	if !errors.As(err, &inventoryErr) || len(inventoryErr.Errors) != 1 || inventoryErr.Errors[InventoryDomains] == nil {
+		t.Fatalf("expected only domains to fail, got %v", err)
+	}
+
+	if counts.Instances != 120 || counts.Images != 3 || counts.Volumes != 0 {
+		t.Errorf("unexpected counts %+v", counts)
+	}
+
+	if len(queries) != len(allInventoryResources) {
+		t.Errorf("expected one request per resource, got %v", queries)
+	}
+
+	if query := queries["/v4/linode/instances"]; query.Get("page") != "1" || query.Get("page_size") != "25" {
+		t.Errorf("expected only the first page to be fetched, got %v", query)
+	}
+
+	if filters["/v4/images"] != `{"is_public":false}` {
+		t.Errorf("expected only owned images to be counted, got %s", filters["/v4/images"])
+	}
+}
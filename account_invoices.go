@@ -121,29 +121,8 @@ type InvoiceItemsPagedResponse struct {
 	Data []InvoiceItem `json:"data"`
 }
 
-// endpoint gets the endpoint URL for InvoiceItems associated with a specific Invoice
-func (InvoiceItemsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("account/invoices/%d/items", id)
-}
-
-func (resp *InvoiceItemsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(InvoiceItemsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*InvoiceItemsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListInvoiceItems gets the invoice items associated with a specific Invoice
 func (c *Client) ListInvoiceItems(ctx context.Context, invoiceID int, opts *ListOptions) ([]InvoiceItem, error) {
-	response := InvoiceItemsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, invoiceID)
-	if err != nil {
-		return nil, err
-	}
-
-	return response.Data, nil
+	e := fmt.Sprintf("account/invoices/%d/items", invoiceID)
+	return listPaginated[InvoiceItem](ctx, c, e, opts)
 }
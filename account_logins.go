@@ -12,7 +12,7 @@ import (
 
 type Login struct {
 	ID         int        `json:"id"`
-	Datetime   *time.Time `json:"datetime"`
+	Datetime   *time.Time `json:"-"`
 	IP         string     `json:"ip"`
 	Restricted bool       `json:"restricted"`
 	Username   string     `json:"username"`
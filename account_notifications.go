@@ -114,3 +114,35 @@ func (i *Notification) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// TimeUntil returns the duration until the Notification's When timestamp.
+// A negative duration is returned if When has already passed, and zero if When is not set.
+func (i Notification) TimeUntil() time.Duration {
+	return timeUntil(i.When)
+}
+
+// IsImminent returns true if the Notification's When timestamp falls within the
+// given duration from now. Notifications that have already started are considered
+// imminent until their Until timestamp has passed; started Notifications without
+// an Until timestamp are not considered imminent.
+func (i Notification) IsImminent(within time.Duration) bool {
+	if i.When == nil {
+		return false
+	}
+
+	timeUntilStart := i.TimeUntil()
+	if timeUntilStart >= 0 {
+		return timeUntilStart <= within
+	}
+
+	return i.Until != nil && time.Now().Before(*i.Until)
+}
+
+// timeUntil returns the duration until t, or zero if t is nil.
+func timeUntil(t *time.Time) time.Duration {
+	if t == nil {
+		return 0
+	}
+
+	return time.Until(*t)
+}
@@ -0,0 +1,45 @@
+package linodego
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotification_IsImminent(t *testing.T) {
+	at := func(d time.Duration) *time.Time {
+		ts := time.Now().Add(d)
+		return &ts
+	}
+
+	tests := []struct {
+		name         string
+		notification Notification
+		expected     bool
+	}{
+		{"no when", Notification{}, false},
+		{"within window", Notification{When: at(time.Hour)}, true},
+		{"outside window", Notification{When: at(48 * time.Hour)}, false},
+		{"started without until", Notification{When: at(-time.Hour)}, false},
+		{"started before until", Notification{When: at(-time.Hour), Until: at(time.Hour)}, true},
+		{"ended", Notification{When: at(-2 * time.Hour), Until: at(-time.Hour)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := tt.notification.IsImminent(24 * time.Hour); actual != tt.expected {
+				t.Errorf("expected IsImminent to be %v but got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNotification_TimeUntil(t *testing.T) {
+	if d := (Notification{}).TimeUntil(); d != 0 {
+		t.Errorf("expected zero duration without When but got %s", d)
+	}
+
+	when := time.Now().Add(time.Hour)
+	if d := (Notification{When: &when}).TimeUntil(); d <= 0 || d > time.Hour {
+		t.Errorf("expected duration of up to an hour but got %s", d)
+	}
+}
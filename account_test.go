@@ -0,0 +1,67 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CheckAccountReady(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/account": {http.StatusOK, `{"email": "user@example.com", "active_since": "2024-01-02T03:04:05"}`},
+		"/v4/linode/instances": {
+			http.StatusForbidden,
+			`{"errors": [{"reason": "Your account must be activated before you can use this endpoint"}]}`,
+		},
+	})
+	defer ts.Close()
+
+	err := client.CheckAccountReady(context.Background())
+	if !errors.Is(err, ErrAccountNotActivated) || !IsAccountNotActivated(err) {
+		t.Fatalf("expected ErrAccountNotActivated but got %v", err)
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+		t.Errorf("expected the API error to be wrapped, got %v", err)
+	}
+}
+
+func TestClient_CheckAccountReady_notActive(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/account":          {http.StatusOK, `{"email": "user@example.com", "active_since": null}`},
+		"/v4/linode/instances": {http.StatusOK, `{"data": [], "page": 1, "pages": 1, "results": 0}`},
+	})
+	defer ts.Close()
+
+	if err := client.CheckAccountReady(context.Background()); !errors.Is(err, ErrAccountNotActivated) {
+		t.Fatalf("expected ErrAccountNotActivated but got %v", err)
+	}
+}
+
+func TestClient_CheckAccountReady_ready(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/account":          {http.StatusOK, `{"email": "user@example.com", "active_since": "2024-01-02T03:04:05"}`},
+		"/v4/linode/instances": {http.StatusOK, `{"data": [], "page": 1, "pages": 1, "results": 0}`},
+	})
+	defer ts.Close()
+
+	if err := client.CheckAccountReady(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsAccountNotActivated(t *testing.T) {
+	if IsAccountNotActivated(&Error{Code: http.StatusForbidden, Message: "Unauthorized"}) {
+		t.Error("expected a generic 403 not to be classified as account not activated")
+	}
+
+	if IsAccountNotActivated(&Error{Code: http.StatusBadRequest, Message: "Linode is pending verification"}) {
+		t.Error("expected an unrelated error not to be classified as account not activated")
+	}
+
+	if !IsAccountNotActivated(Error{Code: http.StatusForbidden, Message: "Your account must be activated before you can use this endpoint"}) {
+		t.Error("expected error to be classified as account not activated")
+	}
+}
@@ -4,12 +4,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
+// UserStatus represents whether a User has accepted their invitation
+type UserStatus string
+
+// UserStatus constants reflect the confirmation state of a User
+const (
+	UserStatusPending UserStatus = "pending"
+	UserStatusActive  UserStatus = "active"
+)
+
 // User represents a User object
 type User struct {
 	Username            string     `json:"username"`
@@ -28,6 +39,35 @@ type UserCreateOptions struct {
 	Restricted bool   `json:"restricted"`
 }
 
+// UserWithGrants describes a User to be created by CreateUsersWithGrants.
+// Grants are applied after the User is created; they are skipped if nil.
+type UserWithGrants struct {
+	User   UserCreateOptions
+	Grants *UserGrantsUpdateOptions
+}
+
+// UserBatchError is returned by CreateUsersWithGrants when one or more Users
+// could not be provisioned. Errors is keyed by the username of each failed User.
+type UserBatchError struct {
+	Errors map[string]error
+}
+
+func (e UserBatchError) Error() string {
+	usernames := make([]string, 0, len(e.Errors))
+	for username := range e.Errors {
+		usernames = append(usernames, username)
+	}
+
+	sort.Strings(usernames)
+
+	messages := make([]string, len(usernames))
+	for i, username := range usernames {
+		messages[i] = fmt.Sprintf("%s: %s", username, e.Errors[username])
+	}
+
+	return fmt.Sprintf("failed to create %d user(s): %s", len(usernames), strings.Join(messages, "; "))
+}
+
 // UserUpdateOptions fields are those accepted by UpdateUser
 type UserUpdateOptions struct {
 	Username   string `json:"username,omitempty"`
@@ -54,6 +94,21 @@ func (i *User) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Status returns the confirmation state of the User.
+// Invited Users are pending until they accept the invitation email and set a password.
+func (i User) Status() UserStatus {
+	if i.PasswordCreated == nil {
+		return UserStatusPending
+	}
+
+	return UserStatusActive
+}
+
+// IsPending returns true if the User has not yet accepted their invitation.
+func (i User) IsPending() bool {
+	return i.Status() == UserStatusPending
+}
+
 // GetCreateOptions converts a User to UserCreateOptions for use in CreateUser
 func (i User) GetCreateOptions() (o UserCreateOptions) {
 	o.Username = i.Username
@@ -133,6 +188,53 @@ func (c *Client) CreateUser(ctx context.Context, opts UserCreateOptions) (*User,
 	return r.Result().(*User), nil
 }
 
+// CreateUsersWithGrants creates each of the given Users and applies their Grants.
+// Provisioning continues when a User fails; if a User is created but its Grants
+// cannot be applied, the User is deleted on a best-effort basis.
+// The successfully provisioned Users are returned along with a UserBatchError
+// describing every failure, if any.
+func (c *Client) CreateUsersWithGrants(ctx context.Context, specs []UserWithGrants) ([]*User, error) {
+	users := make([]*User, 0, len(specs))
+	batchErr := UserBatchError{Errors: make(map[string]error)}
+
+	for _, spec := range specs {
+		user, err := c.createUserWithGrants(ctx, spec)
+		if err != nil {
+			batchErr.Errors[spec.User.Username] = err
+			continue
+		}
+
+		users = append(users, user)
+	}
+
+	if len(batchErr.Errors) > 0 {
+		return users, batchErr
+	}
+
+	return users, nil
+}
+
+func (c *Client) createUserWithGrants(ctx context.Context, spec UserWithGrants) (*User, error) {
+	user, err := c.CreateUser(ctx, spec.User)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	if spec.Grants == nil {
+		return user, nil
+	}
+
+	if _, err := c.UpdateUserGrants(ctx, user.Username, *spec.Grants); err != nil {
+		if deleteErr := c.DeleteUser(ctx, user.Username); deleteErr != nil {
+			return nil, fmt.Errorf("failed to update user grants: %w (cleanup failed: %s)", err, deleteErr)
+		}
+
+		return nil, fmt.Errorf("failed to update user grants: %w", err)
+	}
+
+	return user, nil
+}
+
 // UpdateUser updates the User with the specified id
 func (c *Client) UpdateUser(ctx context.Context, userID string, opts UserUpdateOptions) (*User, error) {
 	body, err := json.Marshal(opts)
@@ -0,0 +1,96 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUser_Status(t *testing.T) {
+	var pending, active User
+
+	if err := json.Unmarshal([]byte(`{"username": "invited", "password_created": null}`), &pending); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"username": "accepted", "password_created": "2018-01-01T00:01:01"}`), &active); err != nil {
+		t.Fatal(err)
+	}
+
+	if pending.Status() != UserStatusPending || !pending.IsPending() {
+		t.Errorf("expected user without a password to be pending, got %s", pending.Status())
+	}
+
+	if active.Status() != UserStatusActive || active.IsPending() {
+		t.Errorf("expected user with a password to be active, got %s", active.Status())
+	}
+}
+
+func TestClient_CreateUsersWithGrants(t *testing.T) {
+	var deleted []string
+
+	h := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/account/users":
+			var opts UserCreateOptions
+			if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if opts.Username == "taken" {
+				rw.WriteHeader(http.StatusBadRequest)
+				rw.Write([]byte(`{"errors": [{"reason": "Username taken", "field": "username"}]}`))
+				return
+			}
+
+			json.NewEncoder(rw).Encode(User{Username: opts.Username, Email: opts.Email})
+		case r.Method == http.MethodPut && r.URL.Path == "/v4/account/users/badgrants/grants":
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"errors": [{"reason": "Invalid grants"}]}`))
+		case r.Method == http.MethodPut:
+			rw.Write([]byte(`{}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/v4/account/users/"))
+			rw.Write([]byte(`{}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	grants := &UserGrantsUpdateOptions{}
+	users, err := client.CreateUsersWithGrants(context.Background(), []UserWithGrants{
+		{User: UserCreateOptions{Username: "ok"}, Grants: grants},
+		{User: UserCreateOptions{Username: "taken"}, Grants: grants},
+		{User: UserCreateOptions{Username: "badgrants"}, Grants: grants},
+		{User: UserCreateOptions{Username: "nogrants"}},
+	})
+
+	var batchErr UserBatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected UserBatchError but got %#v", err)
+	}
+
+	if len(batchErr.Errors) != 2 || batchErr.Errors["taken"] == nil || batchErr.Errors["badgrants"] == nil {
+		t.Errorf("expected failures for taken and badgrants, got %v", batchErr.Errors)
+	}
+
+	if len(users) != 2 || users[0].Username != "ok" || users[1].Username != "nogrants" {
+		t.Errorf("unexpected users returned: %v", users)
+	}
+
+	if len(deleted) != 1 || deleted[0] != "badgrants" {
+		t.Errorf("expected only badgrants to be cleaned up, got %v", deleted)
+	}
+}
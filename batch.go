@@ -0,0 +1,54 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxIDsPerFilter is the number of IDs fetched with a single "+or" filter,
+// keeping the X-Filter header of each request to a reasonable size.
+const maxIDsPerFilter = 100
+
+// getByIDs fetches the resources with the given IDs using "+or" filters on list,
+// returning the results in the order of ids. Resources that are not found are nil.
+func getByIDs[T any](
+	ctx context.Context,
+	ids []int,
+	list func(context.Context, *ListOptions) ([]T, error),
+	id func(T) int,
+) ([]*T, error) {
+	found := make(map[int]*T, len(ids))
+
+	for start := 0; start < len(ids); start += maxIDsPerFilter {
+		end := start + maxIDsPerFilter
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		nodes := make([]FilterNode, 0, end-start)
+		for _, resourceID := range ids[start:end] {
+			nodes = append(nodes, &Comp{"id", Eq, resourceID})
+		}
+
+		filter, err := Or("", "", nodes...).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+
+		results, err := list(ctx, NewListOptions(0, string(filter)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources by ID: %w", err)
+		}
+
+		for i := range results {
+			found[id(results[i])] = &results[i]
+		}
+	}
+
+	result := make([]*T, len(ids))
+	for i, resourceID := range ids {
+		result[i] = found[resourceID]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetInstances(t *testing.T) {
+	var filters []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		filters = append(filters, r.Header.Get("X-Filter"))
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [{"id": 3}, {"id": 1}], "page": 1, "pages": 1, "results": 2}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	instances, err := client.GetInstances(context.Background(), []int{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"+or":[{"id":1},{"id":2},{"id":3}]}`; len(filters) != 1 || filters[0] != expected {
+		t.Errorf("expected a single request with filter %s, got %v", expected, filters)
+	}
+
+	if len(instances) != 3 || instances[0].ID != 1 || instances[1] != nil || instances[2].ID != 3 {
+		t.Errorf("unexpected instances: %v", instances)
+	}
+}
+
+func TestClient_GetInstances_chunked(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	ids := make([]int, maxIDsPerFilter+1)
+	for i := range ids {
+		ids[i] = i
+	}
+
+	if _, err := client.GetVolumes(context.Background(), ids); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 2 {
+		t.Errorf("expected %d IDs to take 2 requests, got %d", len(ids), requests)
+	}
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -54,6 +55,11 @@ type Client struct {
 
 	millisecondsPerPoll time.Duration
 
+	// Provides the time to waiters, defaulting to the real clock
+	clock Clock
+
+	retrySettings *retrySettings
+
 	baseURL         string
 	apiVersion      string
 	apiProto        string
@@ -67,6 +73,40 @@ type Client struct {
 	cacheExpiration time.Duration
 	cachedEntries   map[string]clientCacheEntry
 	cachedEntryLock *sync.RWMutex
+
+	// Tracks in-flight requests so the client can be closed gracefully
+	requestTracker *requestTracker
+
+	suggestionsEnabled   bool
+	labelValidation      bool
+	suggestionsHookAdded bool
+
+	// Persists the latest event seen by event pollers
+	eventWatermarkStore WatermarkStore
+
+	// Keeps the latest raw response body for debugging
+	rawResponses *rawResponseCapture
+}
+
+// ConnectionPoolConfig configures the connection pool of the Client's underlying http.Transport.
+// Fields left as their zero value will not modify the existing transport configuration.
+//
+// All API requests are made to a single host, so MaxIdleConnsPerHost is usually the
+// limiting factor under concurrency. A reasonable starting point is to set both
+// MaxIdleConns and MaxIdleConnsPerHost to the number of requests expected to be in flight
+// at once, with an IdleConnTimeout of around 90 seconds.
+type ConnectionPoolConfig struct {
+	// MaxIdleConns is the maximum number of idle (keep-alive) connections across all hosts.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost is the maximum number of idle (keep-alive) connections to keep per host.
+	MaxIdleConnsPerHost int
+
+	// MaxConnsPerHost limits the total number of connections per host, including those in use.
+	MaxConnsPerHost int
+
+	// IdleConnTimeout is the maximum amount of time an idle connection will remain idle before closing itself.
+	IdleConnTimeout time.Duration
 }
 
 type EnvDefaults struct {
@@ -171,10 +211,105 @@ func (c *Client) updateHostURL() {
 
 // SetRootCertificate adds a root certificate to the underlying TLS client config
 func (c *Client) SetRootCertificate(path string) *Client {
-	c.resty.SetRootCertificate(path)
+	c.withBaseTransport(func() {
+		c.resty.SetRootCertificate(path)
+	})
+	return c
+}
+
+// SetTransport sets the http.RoundTripper used for all requests made by this client.
+// This can be used to provide a pre-tuned http.Transport.
+// A nil transport is ignored.
+func (c *Client) SetTransport(transport http.RoundTripper) *Client {
+	if transport == nil {
+		return c
+	}
+
+	c.resty.SetTransport(c.trackTransport(transport))
+	return c
+}
+
+// SetConnectionPool configures the connection pool of the client's http.Transport.
+// This has no effect if the client was created with a custom http.RoundTripper
+// that is not an *http.Transport.
+func (c *Client) SetConnectionPool(config ConnectionPoolConfig) *Client {
+	transport, err := c.httpTransport()
+	if err != nil {
+		log.Printf("[WARN] Unable to configure connection pool: %s", err)
+		return c
+	}
+
+	if config.MaxIdleConns != 0 {
+		transport.MaxIdleConns = config.MaxIdleConns
+	}
+
+	if config.MaxIdleConnsPerHost != 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+
+	if config.MaxConnsPerHost != 0 {
+		transport.MaxConnsPerHost = config.MaxConnsPerHost
+	}
+
+	if config.IdleConnTimeout != 0 {
+		transport.IdleConnTimeout = config.IdleConnTimeout
+	}
+
+	return c
+}
+
+// SetHTTP2 enables or disables HTTP/2 on the client's http.Transport.
+// When disabled, all requests will be made using HTTP/1.1.
+// This must be called before any requests are made with the client.
+func (c *Client) SetHTTP2(enabled bool) *Client {
+	transport, err := c.httpTransport()
+	if err != nil {
+		log.Printf("[WARN] Unable to configure HTTP/2: %s", err)
+		return c
+	}
+
+	transport.ForceAttemptHTTP2 = enabled
+
+	if enabled {
+		// A nil TLSNextProto allows the transport to negotiate HTTP/2
+		transport.TLSNextProto = nil
+	} else {
+		// A non-nil, empty TLSNextProto prevents the transport from negotiating HTTP/2
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
 	return c
 }
 
+// SetKeepAlives enables or disables HTTP keep-alives on the client's http.Transport.
+// When disabled, a new connection will be used for every request.
+func (c *Client) SetKeepAlives(enabled bool) *Client {
+	transport, err := c.httpTransport()
+	if err != nil {
+		log.Printf("[WARN] Unable to configure keep-alives: %s", err)
+		return c
+	}
+
+	transport.DisableKeepAlives = !enabled
+
+	return c
+}
+
+// httpTransport returns the client's underlying *http.Transport
+func (c *Client) httpTransport() (*http.Transport, error) {
+	roundTripper := c.resty.GetClient().Transport
+	if tracking, ok := roundTripper.(*trackingTransport); ok {
+		roundTripper = tracking.base
+	}
+
+	transport, ok := roundTripper.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("expected transport to be *http.Transport but got %T", roundTripper)
+	}
+
+	return transport, nil
+}
+
 // SetToken sets the API token for all requests from this client
 // Only necessary if you haven't already provided an http client to NewClient() configured with the token.
 func (c *Client) SetToken(token string) *Client {
@@ -183,9 +318,12 @@ func (c *Client) SetToken(token string) *Client {
 }
 
 // SetRetries adds retry conditions for "Linode Busy." errors and 429s.
+// Retrying "Linode busy." errors can be disabled with SetLinodeBusyRetry.
 func (c *Client) SetRetries() *Client {
 	c.
-		addRetryConditional(linodeBusyRetryCondition).
+		addRetryConditional(func(r *resty.Response, err error) bool {
+			return !c.retrySettings.linodeBusyDisabled && linodeBusyRetryCondition(r, err)
+		}).
 		addRetryConditional(tooManyRequestsRetryCondition).
 		addRetryConditional(serviceUnavailableRetryCondition).
 		addRetryConditional(requestTimeoutRetryCondition).
@@ -196,9 +334,17 @@ func (c *Client) SetRetries() *Client {
 	return c
 }
 
-// AddRetryCondition adds a RetryConditional function to the Client
+// AddRetryCondition adds a RetryConditional function to the Client.
+// Requests made with a context from WithRetryCount are only retried by it within their count.
 func (c *Client) AddRetryCondition(retryCondition RetryConditional) *Client {
-	c.resty.AddRetryCondition(resty.RetryConditionFunc(retryCondition))
+	c.resty.AddRetryCondition(func(r *resty.Response, err error) bool {
+		if r != nil && !retryAllowedByContext(r) {
+			return false
+		}
+
+		return retryCondition(r, err)
+	})
+
 	return c
 }
 
@@ -281,6 +427,47 @@ func (c *Client) getCachedResponse(endpoint string) any {
 	return c.cachedEntries[endpoint].Data
 }
 
+// cachedListResponse is a cached response of a List endpoint along with its pagination
+// metadata, so that the metadata can be restored into the ListOptions of a cache hit
+type cachedListResponse struct {
+	data    any
+	pages   int
+	results int
+}
+
+// addCachedListResponse caches the data returned by a List endpoint along with the
+// pagination metadata that was set on opts
+func (c *Client) addCachedListResponse(endpoint string, data any, opts *ListOptions, expiry *time.Duration) {
+	entry := cachedListResponse{data: data}
+
+	if opts != nil && opts.PageOptions != nil {
+		entry.pages = opts.Pages
+		entry.results = opts.Results
+	}
+
+	c.addCachedResponse(endpoint, entry, expiry)
+}
+
+// getCachedListResponse returns the cached data of a List endpoint, or nil if it has not been
+// cached. The cached pagination metadata is set on opts, as it would be by the request.
+func (c *Client) getCachedListResponse(endpoint string, opts *ListOptions) any {
+	entry, ok := c.getCachedResponse(endpoint).(cachedListResponse)
+	if !ok {
+		return nil
+	}
+
+	if opts != nil {
+		if opts.PageOptions == nil {
+			opts.PageOptions = &PageOptions{}
+		}
+
+		opts.Pages = entry.pages
+		opts.Results = entry.results
+	}
+
+	return entry.data
+}
+
 // InvalidateCache clears all cached responses for all endpoints.
 func (c *Client) InvalidateCache() {
 	c.cachedEntryLock.Lock()
@@ -336,8 +523,18 @@ func (c *Client) SetRetryAfter(callback RetryAfter) *Client {
 }
 
 // SetRetryCount sets the maximum retry attempts before aborting.
+// A count of 0 disables retries entirely, so each request is attempted exactly once
+// whatever its response, and the count is kept if SetRetries is called again.
+// Negative counts are treated as 0.
 func (c *Client) SetRetryCount(count int) *Client {
+	if count < 0 {
+		count = 0
+	}
+
+	c.retrySettings.count = count
+	c.retrySettings.countSet = true
 	c.resty.SetRetryCount(count)
+
 	return c
 }
 
@@ -364,16 +561,25 @@ func (c *Client) SetHeader(name, value string) {
 // NewClient factory to create new Client struct
 func NewClient(hc *http.Client) (client Client) {
 	if hc != nil {
-		client.resty = resty.NewWithClient(hc)
+		// Copy the provided client so installing the request tracking
+		// transport doesn't modify the caller's http.Client
+		hcCopy := *hc
+		client.resty = resty.NewWithClient(&hcCopy)
 	} else {
 		client.resty = resty.New()
 	}
 
+	client.retrySettings = &retrySettings{}
+
 	client.shouldCache = true
 	client.cacheExpiration = time.Minute * 15
 	client.cachedEntries = make(map[string]clientCacheEntry)
 	client.cachedEntryLock = &sync.RWMutex{}
 
+	client.requestTracker = newRequestTracker()
+	client.eventWatermarkStore = newMemoryWatermarkStore()
+	client.resty.SetTransport(client.trackTransport(client.resty.GetClient().Transport))
+
 	client.SetUserAgent(DefaultUserAgent)
 
 	baseURL, baseURLExists := os.LookupEnv(APIHostVar)
@@ -521,7 +727,7 @@ func copyTime(tPtr *time.Time) *time.Time {
 
 func generateListCacheURL(endpoint string, opts *ListOptions) (string, error) {
 	if opts == nil {
-		return "", nil
+		return endpoint, nil
 	}
 
 	hashedOpts, err := opts.Hash()
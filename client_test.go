@@ -1,8 +1,14 @@
 package linodego
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -111,3 +117,156 @@ api_version = v4beta
 [cool]
 token = blah
 `
+
+func TestClient_SetConnectionPool(t *testing.T) {
+	client := NewClient(nil)
+
+	client.SetConnectionPool(ConnectionPoolConfig{
+		MaxIdleConns:        50,
+		MaxIdleConnsPerHost: 25,
+		IdleConnTimeout:     time.Minute,
+	})
+
+	transport, err := client.httpTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if transport.MaxIdleConns != 50 || transport.MaxIdleConnsPerHost != 25 || transport.IdleConnTimeout != time.Minute {
+		t.Fatalf("connection pool was not configured: %d, %d, %s",
+			transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+
+	// Unset fields should be left as-is
+	if transport.MaxConnsPerHost != 0 {
+		t.Fatalf("expected MaxConnsPerHost to be unchanged, got %d", transport.MaxConnsPerHost)
+	}
+
+	custom := &http.Transport{MaxIdleConnsPerHost: 100}
+	client.SetTransport(custom)
+
+	if transport, err := client.httpTransport(); err != nil || transport != custom {
+		t.Fatalf("expected custom transport to be used: %v", err)
+	}
+}
+
+func TestClient_SetHTTP2(t *testing.T) {
+	client := NewClient(nil)
+
+	transport, err := client.httpTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.SetHTTP2(false)
+
+	if transport.ForceAttemptHTTP2 || transport.TLSNextProto == nil {
+		t.Fatal("expected HTTP/2 to be disabled")
+	}
+
+	client.SetHTTP2(true)
+
+	if !transport.ForceAttemptHTTP2 || transport.TLSNextProto != nil {
+		t.Fatal("expected HTTP/2 to be enabled")
+	}
+}
+
+func TestClient_SetKeepAlives(t *testing.T) {
+	client := NewClient(nil)
+
+	transport, err := client.httpTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.SetKeepAlives(false)
+
+	if !transport.DisableKeepAlives {
+		t.Fatal("expected keep-alives to be disabled")
+	}
+
+	client.SetKeepAlives(true)
+
+	if transport.DisableKeepAlives {
+		t.Fatal("expected keep-alives to be enabled")
+	}
+}
+
+func TestClient_Close(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var startedOnce, releaseOnce sync.Once
+	releaseHandler := func() { releaseOnce.Do(func() { close(release) }) }
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		startedOnce.Do(func() { close(started) })
+		<-release
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": "us-east"}`))
+	}))
+	defer ts.Close()
+	defer releaseHandler()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	requestErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetRegion(context.Background(), "us-east")
+		requestErr <- err
+	}()
+
+	<-started
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := client.Close(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected Close to time out while a request is in flight, got %v", err)
+	}
+
+	if _, err := client.GetRegion(context.Background(), "us-west"); !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("expected ErrClientClosed, got %v", err)
+	}
+
+	releaseHandler()
+
+	if err := <-requestErr; err != nil {
+		t.Fatalf("expected in-flight request to succeed, got %v", err)
+	}
+
+	if err := client.Close(context.Background()); err != nil {
+		t.Fatalf("expected Close to succeed once drained, got %v", err)
+	}
+}
+
+func TestNewClient_doesNotModifyHTTPClient(t *testing.T) {
+	transport := &http.Transport{}
+	hc := &http.Client{Transport: transport}
+
+	client := NewClient(hc)
+	client.SetRootCertificate("nonexistent.pem")
+
+	if hc.Transport != transport {
+		t.Fatalf("expected the provided http.Client to be unmodified, got transport %T", hc.Transport)
+	}
+
+	clientTransport, err := client.httpTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if clientTransport != transport {
+		t.Fatal("expected the client to use the provided transport")
+	}
+}
+
+func TestGenerateListCacheURL_nilOptions(t *testing.T) {
+	types, _ := generateListCacheURL("linode/types", nil)
+	databaseTypes, _ := generateListCacheURL("databases/types", nil)
+
+	if types == databaseTypes {
+		t.Errorf("expected unfiltered lists of different endpoints to be cached separately, both used %q", types)
+	}
+}
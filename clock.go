@@ -0,0 +1,148 @@
+package linodego
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock provides the time to the WaitFor helpers and event pollers of a Client.
+// Replacing it with SetClock allows their polling and timeouts to be tested
+// without waiting in real time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After returns a channel that receives the current time once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+
+	// NewTicker returns a Ticker that ticks every d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks at intervals, as returned by Clock.NewTicker.
+type Ticker interface {
+	// C returns the channel the ticks are delivered on.
+	C() <-chan time.Time
+
+	// Stop turns off the Ticker.
+	Stop()
+}
+
+// realClock is the default Clock, backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+func (t realTicker) Stop() {
+	t.ticker.Stop()
+}
+
+// SetClock sets the Clock used by the Client's WaitFor helpers and event pollers.
+// By default, the real clock is used. Retries of failed requests are timed by resty
+// and always use the real clock.
+func (c *Client) SetClock(clock Clock) *Client {
+	c.clock = clock
+	return c
+}
+
+// getClock returns the Client's Clock, defaulting to the real clock.
+func (c *Client) getClock() Clock {
+	if c.clock == nil {
+		return realClock{}
+	}
+
+	return c.clock
+}
+
+// withTimeout returns a copy of ctx that is cancelled once timeout has elapsed on the
+// Client's Clock, in the same way as context.WithTimeout.
+func (c *Client) withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	clock := c.getClock()
+	if _, ok := clock.(realClock); ok {
+		return context.WithTimeout(ctx, timeout)
+	}
+
+	result := &clockTimeoutContext{
+		parent:   ctx,
+		deadline: clock.Now().Add(timeout),
+		done:     make(chan struct{}),
+	}
+
+	go func() {
+		select {
+		case <-clock.After(timeout):
+			result.cancel(context.DeadlineExceeded)
+		case <-ctx.Done():
+			result.cancel(ctx.Err())
+		case <-result.done:
+		}
+	}()
+
+	return result, func() { result.cancel(context.Canceled) }
+}
+
+// clockTimeoutContext is a context that times out on a Clock other than the real clock.
+// It does not embed its parent so that contexts derived from it report its error
+// rather than that of its parent.
+type clockTimeoutContext struct {
+	parent   context.Context
+	deadline time.Time
+	done     chan struct{}
+
+	lock sync.Mutex
+	err  error
+}
+
+func (c *clockTimeoutContext) cancel(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err != nil {
+		return
+	}
+
+	c.err = err
+	close(c.done)
+}
+
+func (c *clockTimeoutContext) Deadline() (time.Time, bool) {
+	if deadline, ok := c.parent.Deadline(); ok && deadline.Before(c.deadline) {
+		return deadline, true
+	}
+
+	return c.deadline, true
+}
+
+func (c *clockTimeoutContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *clockTimeoutContext) Err() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.err
+}
+
+func (c *clockTimeoutContext) Value(key any) any {
+	return c.parent.Value(key)
+}
@@ -0,0 +1,133 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// manualClock is a Clock that only advances when Advance is called
+type manualClock struct {
+	lock    sync.Mutex
+	now     time.Time
+	timers  []*manualTimer
+	tickers []*manualTicker
+}
+
+type manualTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+type manualTicker struct {
+	next   time.Time
+	period time.Duration
+	c      chan time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.now
+}
+
+func (c *manualClock) After(d time.Duration) <-chan time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	timer := &manualTimer{at: c.now.Add(d), c: make(chan time.Time, 1)}
+	c.timers = append(c.timers, timer)
+
+	return timer.c
+}
+
+func (c *manualClock) NewTicker(d time.Duration) Ticker {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ticker := &manualTicker{next: c.now.Add(d), period: d, c: make(chan time.Time, 1)}
+	c.tickers = append(c.tickers, ticker)
+
+	return ticker
+}
+
+func (t *manualTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *manualTicker) Stop() {}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = c.now.Add(d)
+
+	pending := c.timers[:0]
+
+	for _, timer := range c.timers {
+		if timer.at.After(c.now) {
+			pending = append(pending, timer)
+			continue
+		}
+
+		timer.c <- c.now
+	}
+
+	c.timers = pending
+
+	for _, ticker := range c.tickers {
+		if ticker.next.After(c.now) {
+			continue
+		}
+
+		for !ticker.next.After(c.now) {
+			ticker.next = ticker.next.Add(ticker.period)
+		}
+
+		select {
+		case ticker.c <- c.now:
+		default:
+		}
+	}
+}
+
+func TestClient_SetClock(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123", "application/json", `{"id": 123, "status": "booting"}`, http.StatusOK)
+	defer ts.Close()
+
+	clock := &manualClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	client.SetClock(clock)
+	client.SetPollDelay(5000)
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := client.WaitForInstanceStatus(context.Background(), 123, InstanceRunning, 60)
+		done <- err
+	}()
+
+	for i := 0; i < 100; i++ {
+		clock.Advance(5 * time.Second)
+
+		select {
+		case err := <-done:
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("expected the wait to time out, got %v", err)
+			}
+
+			if elapsed := clock.Now().Sub(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)); elapsed < time.Minute {
+				t.Errorf("expected the wait to time out after a minute, timed out after %s", elapsed)
+			}
+
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	t.Fatal("expected the wait to time out on the clock")
+}
@@ -0,0 +1,198 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+)
+
+// CostBreakdown is an estimate of the monthly cost of the resources on an Account
+type CostBreakdown struct {
+	// Total is the estimated monthly cost of all of the priced resources
+	Total float64
+
+	// ByResource is the estimated monthly cost of each type of resource
+	ByResource map[InventoryResource]float64
+
+	// Unpriced counts the resources of each type whose price could not be determined,
+	// such as those whose plan is no longer listed. They are not included in Total.
+	Unpriced map[InventoryResource]int
+
+	// Unlisted are the types of resource that could not be listed, e.g. because the user
+	// has no access to them. Their cost is not included in Total.
+	Unlisted []InventoryResource
+}
+
+// costEstimateResources are the resources with a price that EstimateMonthlyCost can look up.
+// LKE node pools are made up of Instances, so their cost is included in that of the Instances.
+var costEstimateResources = []InventoryResource{
+	InventoryInstances,
+	InventoryVolumes,
+	InventoryNodeBalancers,
+	InventoryDatabases,
+}
+
+// EstimateMonthlyCost makes a best-effort estimate of the monthly cost of the Instances (including
+// their backups), Volumes, NodeBalancers and Managed Databases on the Account, using the current
+// prices of their plans in their regions. It does not account for network transfer overages,
+// Object Storage, LKE control planes, Images, promotions or credits, so it is not a substitute
+// for the Account's invoices.
+// If some of the resources or their prices could not be fetched, the estimate of the others is
+// returned along with an *InventoryError describing each failure. Resources that could not be
+// listed are in Unlisted, and resources whose prices could not be listed are counted in Unpriced.
+func (c *Client) EstimateMonthlyCost(ctx context.Context) (*CostBreakdown, error) {
+	inventory, err := c.GetAccountInventory(ctx, InventoryOptions{Resources: costEstimateResources})
+
+	errs := make(map[InventoryResource]error)
+
+	if err != nil {
+		var inventoryErr *InventoryError
+		if !errors.As(err, &inventoryErr) {
+			return nil, err
+		}
+
+		for resource, resourceErr := range inventoryErr.Errors {
+			errs[resource] = resourceErr
+		}
+	}
+
+	estimate := &CostBreakdown{
+		ByResource: make(map[InventoryResource]float64),
+		Unpriced:   make(map[InventoryResource]int),
+	}
+
+	for _, resource := range costEstimateResources {
+		if errs[resource] != nil {
+			estimate.Unlisted = append(estimate.Unlisted, resource)
+		}
+	}
+
+	add := func(resource InventoryResource, price *LinodePrice, quantity int) {
+		if price == nil {
+			estimate.Unpriced[resource]++
+			return
+		}
+
+		cost := float32ToFloat64(price.Monthly) * float64(quantity)
+		estimate.ByResource[resource] += cost
+		estimate.Total += cost
+	}
+
+	// unpriced records that the prices of resource could not be listed
+	unpriced := func(resource InventoryResource, count int, err error) {
+		estimate.Unpriced[resource] += count
+		errs[resource] = err
+	}
+
+	if len(inventory.Instances) > 0 {
+		types, err := c.ListTypes(ctx, nil)
+		if err != nil {
+			unpriced(InventoryInstances, len(inventory.Instances), err)
+		} else {
+			estimateInstancesCost(inventory.Instances, types, add)
+		}
+	}
+
+	if len(inventory.Volumes) > 0 {
+		types, err := c.ListVolumeTypes(ctx, nil)
+		if err != nil {
+			unpriced(InventoryVolumes, len(inventory.Volumes), err)
+		} else {
+			// All Volumes share a single type
+			for _, volume := range inventory.Volumes {
+				var price *LinodePrice
+				if len(types) > 0 {
+					price = types[0].PriceForRegion(volume.Region)
+				}
+
+				add(InventoryVolumes, price, volume.Size)
+			}
+		}
+	}
+
+	if len(inventory.NodeBalancers) > 0 {
+		types, err := c.ListNodeBalancerTypes(ctx, nil)
+		if err != nil {
+			unpriced(InventoryNodeBalancers, len(inventory.NodeBalancers), err)
+		} else {
+			// All NodeBalancers share a single type
+			for _, nodebalancer := range inventory.NodeBalancers {
+				var price *LinodePrice
+				if len(types) > 0 {
+					price = types[0].PriceForRegion(nodebalancer.Region)
+				}
+
+				add(InventoryNodeBalancers, price, 1)
+			}
+		}
+	}
+
+	if len(inventory.Databases) > 0 {
+		types, err := c.ListDatabaseTypes(ctx, nil)
+		if err != nil {
+			unpriced(InventoryDatabases, len(inventory.Databases), err)
+		} else {
+			for _, database := range inventory.Databases {
+				add(InventoryDatabases, databaseMonthlyPrice(types, database), 1)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return estimate, &InventoryError{Errors: errs}
+	}
+
+	return estimate, nil
+}
+
+// estimateInstancesCost adds the cost of Instances and their backups with the prices of types
+func estimateInstancesCost(instances []Instance, types []LinodeType, add func(InventoryResource, *LinodePrice, int)) {
+	typesByID := make(map[string]LinodeType, len(types))
+	for _, linodeType := range types {
+		typesByID[linodeType.ID] = linodeType
+	}
+
+	for _, instance := range instances {
+		linodeType, ok := typesByID[instance.Type]
+		if !ok {
+			add(InventoryInstances, nil, 1)
+			continue
+		}
+
+		add(InventoryInstances, linodeType.PriceForRegion(instance.Region), 1)
+
+		if instance.Backups != nil && instance.Backups.Enabled {
+			var price *LinodePrice
+			if linodeType.Addons != nil && linodeType.Addons.Backups != nil {
+				price = linodeType.Addons.Backups.PriceForRegion(instance.Region)
+			}
+
+			add(InventoryInstances, price, 1)
+		}
+	}
+}
+
+// databaseMonthlyPrice returns the price of a Database's type, engine and cluster size, if it is listed
+func databaseMonthlyPrice(types []DatabaseType, database Database) *LinodePrice {
+	for _, databaseType := range types {
+		if databaseType.ID != database.Type {
+			continue
+		}
+
+		var engines []DatabaseTypeEngine
+
+		switch DatabaseEngineType(database.Engine) {
+		case DatabaseEngineTypeMySQL:
+			engines = databaseType.Engines.MySQL
+		case DatabaseEngineTypePostgres:
+			engines = databaseType.Engines.PostgreSQL
+		}
+
+		for _, engine := range engines {
+			if engine.Quantity == database.ClusterSize {
+				return &LinodePrice{Hourly: engine.Price.Hourly, Monthly: engine.Price.Monthly}
+			}
+		}
+	}
+
+	return nil
+}
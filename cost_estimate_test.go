@@ -0,0 +1,102 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestClient_EstimateMonthlyCost(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances": {http.StatusOK, `{"data": [
+			{"id": 1, "type": "g6-standard-1", "region": "us-east", "backups": {"enabled": true}},
+			{"id": 2, "type": "g6-standard-1", "region": "id-cgk", "backups": {"enabled": false}},
+			{"id": 3, "type": "g5-retired", "region": "us-east"}
+		], "page": 1, "pages": 1, "results": 3}`},
+		"/v4/linode/types": {http.StatusOK, `{"data": [{
+			"id": "g6-standard-1",
+			"price": {"hourly": 0.018, "monthly": 12},
+			"region_prices": [{"id": "id-cgk", "hourly": 0.0216, "monthly": 14.4}],
+			"addons": {"backups": {"price": {"hourly": 0.003, "monthly": 2}, "region_prices": []}}
+		}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/volumes":             {http.StatusOK, `{"data": [{"id": 4, "size": 20, "region": "us-east"}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/volumes/types":       {http.StatusOK, `{"data": [{"id": "volume", "price": {"hourly": 0.00015, "monthly": 0.1}, "region_prices": []}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/nodebalancers":       {http.StatusOK, `{"data": [{"id": 5, "region": "us-east"}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/nodebalancers/types": {http.StatusOK, `{"data": [{"id": "nodebalancer", "price": {"hourly": 0.015, "monthly": 10}, "region_prices": []}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/databases/instances": {http.StatusOK, `{"data": [{"id": 6, "type": "g6-nanode-1", "engine": "postgresql", "cluster_size": 3}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/databases/types": {http.StatusOK, `{"data": [{
+			"id": "g6-nanode-1",
+			"engines": {"postgresql": [{"quantity": 1, "price": {"hourly": 0.022, "monthly": 15}}, {"quantity": 3, "price": {"hourly": 0.052, "monthly": 35}}]}
+		}], "page": 1, "pages": 1, "results": 1}`},
+	})
+	defer ts.Close()
+
+	estimate, err := client.EstimateMonthlyCost(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[InventoryResource]float64{
+		InventoryInstances:     12 + 2 + 14.4,
+		InventoryVolumes:       20 * 0.1,
+		InventoryNodeBalancers: 10,
+		InventoryDatabases:     35,
+	}
+
+	var total float64
+
+	for resource, cost := range expected {
+		total += cost
+
+		if math.Abs(estimate.ByResource[resource]-cost) > 1e-9 {
+			t.Errorf("expected %s to cost %v, got %v", resource, cost, estimate.ByResource[resource])
+		}
+	}
+
+	if math.Abs(estimate.Total-total) > 1e-9 {
+		t.Errorf("expected a total of %v, got %v", total, estimate.Total)
+	}
+
+	if estimate.Unpriced[InventoryInstances] != 1 || len(estimate.Unpriced) != 1 {
+		t.Errorf("expected only the retired instance to be unpriced, got %v", estimate.Unpriced)
+	}
+}
+
+func TestClient_EstimateMonthlyCost_partial(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances":    {http.StatusOK, `{"data": [{"id": 1, "type": "g6-standard-1", "region": "us-east"}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/linode/types":        {http.StatusOK, `{"data": [{"id": "g6-standard-1", "price": {"hourly": 0.018, "monthly": 12}}], "page": 1, "pages": 1, "results": 1}`},
+		"/v4/volumes":             {http.StatusOK, `{"data": [{"id": 4, "size": 20, "region": "us-east"}, {"id": 5, "size": 10, "region": "us-east"}], "page": 1, "pages": 1, "results": 2}`},
+		"/v4/volumes/types":       {http.StatusInternalServerError, `{"errors": [{"reason": "unavailable"}]}`},
+		"/v4/nodebalancers":       {http.StatusOK, `{"data": [], "page": 1, "pages": 1, "results": 0}`},
+		"/v4/databases/instances": {http.StatusForbidden, `{"errors": [{"reason": "Unauthorized"}]}`},
+	})
+	defer ts.Close()
+
+	client.SetRetryCount(0)
+
+	estimate, err := client.EstimateMonthlyCost(context.Background())
+
+	var inventoryErr *InventoryError
+	if !errors.As(err, &inventoryErr) {
+		t.Fatalf("expected an InventoryError, got %v", err)
+	}
+
+	if len(inventoryErr.Errors) != 2 || inventoryErr.Errors[InventoryDatabases] == nil || inventoryErr.Errors[InventoryVolumes] == nil {
+		t.Errorf("expected the databases and volume prices to fail, got %v", inventoryErr.Errors)
+	}
+
+	if estimate == nil || estimate.Total != 12 {
+		t.Fatalf("expected the estimate of the instances to be returned, got %+v", estimate)
+	}
+
+	if len(estimate.Unlisted) != 1 || estimate.Unlisted[0] != InventoryDatabases {
+		t.Errorf("expected the databases to be unlisted, got %v", estimate.Unlisted)
+	}
+
+	if estimate.Unpriced[InventoryVolumes] != 2 {
+		t.Errorf("expected the volumes to be unpriced, got %v", estimate.Unpriced)
+	}
+}
@@ -164,7 +164,8 @@ type DatabaseType struct {
 
 // DatabaseTypeEngineMap stores a list of Database Engine types by engine
 type DatabaseTypeEngineMap struct {
-	MySQL []DatabaseTypeEngine `json:"mysql"`
+	MySQL      []DatabaseTypeEngine `json:"mysql"`
+	PostgreSQL []DatabaseTypeEngine `json:"postgresql"`
 }
 
 // DatabaseTypeEngine Sizes and Prices
@@ -220,7 +221,7 @@ func (c *Client) ListDatabaseEngines(ctx context.Context, opts *ListOptions) ([]
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedListResponse(endpoint, opts); result != nil {
 		return result.([]DatabaseEngine), nil
 	}
 
@@ -229,7 +230,7 @@ func (c *Client) ListDatabaseEngines(ctx context.Context, opts *ListOptions) ([]
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response.Data, &cacheExpiryTime)
+	c.addCachedListResponse(endpoint, response.Data, opts, &cacheExpiryTime)
 
 	return response.Data, nil
 }
@@ -263,7 +264,7 @@ func (c *Client) ListDatabaseTypes(ctx context.Context, opts *ListOptions) ([]Da
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedListResponse(endpoint, opts); result != nil {
 		return result.([]DatabaseType), nil
 	}
 
@@ -272,7 +273,7 @@ func (c *Client) ListDatabaseTypes(ctx context.Context, opts *ListOptions) ([]Da
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response.Data, &cacheExpiryTime)
+	c.addCachedListResponse(endpoint, response.Data, opts, &cacheExpiryTime)
 
 	return response.Data, nil
 }
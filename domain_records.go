@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/go-resty/resty/v2"
+	"github.com/linode/linodego/internal/parseabletime"
 )
 
 // DomainRecord represents a DomainRecord object
@@ -21,6 +23,8 @@ type DomainRecord struct {
 	Protocol *string          `json:"protocol"`
 	TTLSec   int              `json:"ttl_sec"`
 	Tag      *string          `json:"tag"`
+	Created  *time.Time       `json:"-"`
+	Updated  *time.Time       `json:"-"`
 }
 
 // DomainRecordCreateOptions fields are those accepted by CreateDomainRecord
@@ -67,6 +71,50 @@ const (
 	RecordTypeCAA   DomainRecordType = "CAA"
 )
 
+// SRV record protocols. The API prepends the underscore to the Protocol of an SRV record,
+// so these are given without it.
+const (
+	SRVProtocolTCP  = "tcp"
+	SRVProtocolUDP  = "udp"
+	SRVProtocolTLS  = "tls"
+	SRVProtocolXMPP = "xmpp"
+	SRVProtocolSMTP = "smtp"
+)
+
+// CAA record tags
+const (
+	// CAATagIssue records authorize a CA to issue certificates for the domain
+	CAATagIssue = "issue"
+
+	// CAATagIssueWild records authorize a CA to issue wildcard certificates for the domain
+	CAATagIssueWild = "issuewild"
+
+	// CAATagIODEF records give a mailto: or https:// URL that CAs report policy violations to
+	CAATagIODEF = "iodef"
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *DomainRecord) UnmarshalJSON(b []byte) error {
+	type Mask DomainRecord
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(d),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	d.Created = (*time.Time)(p.Created)
+	d.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
 // GetUpdateOptions converts a DomainRecord to DomainRecordUpdateOptions for use in UpdateDomainRecord
 func (d DomainRecord) GetUpdateOptions() (du DomainRecordUpdateOptions) {
 	du.Type = d.Type
@@ -83,36 +131,160 @@ func (d DomainRecord) GetUpdateOptions() (du DomainRecordUpdateOptions) {
 	return
 }
 
-// DomainRecordsPagedResponse represents a paginated DomainRecord API response
-type DomainRecordsPagedResponse struct {
-	*PageOptions
-	Data []DomainRecord `json:"data"`
+// Validate checks the options for mistakes that the API would reject. SRV records must have a
+// Service, a Protocol, a Target and a Port; their Service and Protocol may be given with or without
+// the leading underscore, which the API adds if it is missing, e.g. "_sip" or "sip" and "_tcp" or "tcp".
+// CAA records must have one of the CAATag constants as their Tag. The Target of an issue or issuewild
+// record is the domain of a CA, optionally followed by parameters, e.g. "letsencrypt.org" or ";" to
+// forbid issuance, and the Target of an iodef record is a mailto: or https:// URL.
+func (o DomainRecordCreateOptions) Validate() error {
+	switch o.Type {
+	case RecordTypeCAA:
+		if o.Tag == nil {
+			return fmt.Errorf("CAA record requires a tag")
+		}
+
+		return validateCAARecordFields(o.Tag, &o.Target)
+	case RecordTypeSRV:
+		switch {
+		case o.Service == nil:
+			return fmt.Errorf("SRV record requires a service")
+		case o.Protocol == nil:
+			return fmt.Errorf("SRV record requires a protocol")
+		case o.Target == "":
+			return fmt.Errorf("SRV record requires a target")
+		case o.Port == nil:
+			return fmt.Errorf("SRV record requires a port")
+		}
+
+		return validateSRVRecordFields(o.Service, o.Protocol, o.Priority, o.Weight, o.Port)
+	}
+
+	return nil
 }
 
-// endpoint gets the endpoint URL for InstanceConfig
-func (DomainRecordsPagedResponse) endpoint(ids ...any) string {
-	id, _ := ids[0].(int)
-	return fmt.Sprintf("domains/%d/records", id)
+// Validate checks the options for mistakes that the API would reject, see DomainRecordCreateOptions.Validate.
+// Only the fields being updated are checked, and only if the Type is set.
+func (o DomainRecordUpdateOptions) Validate() error {
+	switch o.Type {
+	case RecordTypeCAA:
+		var target *string
+		if o.Target != "" {
+			target = &o.Target
+		}
+
+		return validateCAARecordFields(o.Tag, target)
+	case RecordTypeSRV:
+		return validateSRVRecordFields(o.Service, o.Protocol, o.Priority, o.Weight, o.Port)
+	}
+
+	return nil
 }
 
-func (resp *DomainRecordsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(DomainRecordsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
+// validateCAARecordFields checks the tag of a CAA record, and its target if both are given
+func validateCAARecordFields(tag, target *string) error {
+	if tag == nil {
+		return nil
+	}
+
+	switch *tag {
+	case CAATagIssue, CAATagIssueWild:
+		if target == nil {
+			return nil
+		}
+
+		domain := strings.TrimSpace(strings.SplitN(*target, ";", 2)[0])
+		if domain == "" && strings.Contains(*target, ";") {
+			return nil
+		}
+
+		if !isDomainName(domain) {
+			return fmt.Errorf("CAA %s record target %q must be the domain of a CA, e.g. letsencrypt.org", *tag, *target)
+		}
+	case CAATagIODEF:
+		if target == nil {
+			return nil
+		}
+
+		if !strings.HasPrefix(*target, "mailto:") && !strings.HasPrefix(*target, "https://") && !strings.HasPrefix(*target, "http://") {
+			return fmt.Errorf("CAA iodef record target %q must be a mailto: or https:// URL", *target)
+		}
+	default:
+		return fmt.Errorf("CAA record tag %q must be one of %s, %s or %s", *tag, CAATagIssue, CAATagIssueWild, CAATagIODEF)
+	}
+
+	return nil
+}
+
+// isDomainName returns true if name is made up of dot-separated labels of alphanumeric
+// characters and hyphens, which do not begin or end with a hyphen
+func isDomainName(name string) bool {
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+
+		for _, char := range label {
+			if !isAlphanumeric(char) && char != '-' {
+				return false
+			}
+		}
 	}
-	castedRes := res.Result().(*DomainRecordsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
+
+	return true
+}
+
+func validateSRVRecordFields(service, protocol *string, priority, weight, port *int) error {
+	if service != nil {
+		name := strings.TrimPrefix(*service, "_")
+		if name == "" || strings.HasPrefix(name, "-") || strings.HasSuffix(name, "-") {
+			return fmt.Errorf("SRV record service %q must be a service name such as _sip", *service)
+		}
+
+		for _, char := range name {
+			if !isAlphanumeric(char) && char != '-' {
+				return fmt.Errorf("SRV record service %q must be a service name such as _sip", *service)
+			}
+		}
+	}
+
+	if protocol != nil {
+		switch strings.TrimPrefix(*protocol, "_") {
+		case SRVProtocolTCP, SRVProtocolUDP, SRVProtocolTLS, SRVProtocolXMPP, SRVProtocolSMTP:
+		default:
+			return fmt.Errorf("SRV record protocol %q must be one of _tcp, _udp, _tls, _xmpp or _smtp", *protocol)
+		}
+	}
+
+	if priority != nil && (*priority < 0 || *priority > 255) {
+		return fmt.Errorf("SRV record priority must be between 0 and 255, got %d", *priority)
+	}
+
+	if weight != nil && (*weight < 0 || *weight > 65535) {
+		return fmt.Errorf("SRV record weight must be between 0 and 65535, got %d", *weight)
+	}
+
+	if port != nil && (*port < 0 || *port > 65535) {
+		return fmt.Errorf("SRV record port must be between 0 and 65535, got %d", *port)
+	}
+
+	return nil
+}
+
+// DomainRecordsPagedResponse represents a paginated DomainRecord API response
+type DomainRecordsPagedResponse struct {
+	*PageOptions
+	Data []DomainRecord `json:"data"`
 }
 
 // ListDomainRecords lists DomainRecords
 func (c *Client) ListDomainRecords(ctx context.Context, domainID int, opts *ListOptions) ([]DomainRecord, error) {
-	response := DomainRecordsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, domainID)
-	if err != nil {
-		return nil, err
-	}
-	return response.Data, nil
+	e := fmt.Sprintf("domains/%d/records", domainID)
+	return listPaginated[DomainRecord](ctx, c, e, opts)
 }
 
 // GetDomainRecord gets the domainrecord with the provided ID
@@ -128,6 +300,10 @@ func (c *Client) GetDomainRecord(ctx context.Context, domainID int, recordID int
 
 // CreateDomainRecord creates a DomainRecord
 func (c *Client) CreateDomainRecord(ctx context.Context, domainID int, opts DomainRecordCreateOptions) (*DomainRecord, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
@@ -145,6 +321,10 @@ func (c *Client) CreateDomainRecord(ctx context.Context, domainID int, opts Doma
 
 // UpdateDomainRecord updates the DomainRecord with the specified id
 func (c *Client) UpdateDomainRecord(ctx context.Context, domainID int, recordID int, opts DomainRecordUpdateOptions) (*DomainRecord, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
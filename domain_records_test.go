@@ -0,0 +1,105 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDomainRecordCreateOptions_Validate_srv(t *testing.T) {
+	valid := DomainRecordCreateOptions{
+		Type:     RecordTypeSRV,
+		Target:   "sip.example.com",
+		Service:  Pointer("_sip"),
+		Protocol: Pointer("_tcp"),
+		Priority: Pointer(10),
+		Weight:   Pointer(5),
+		Port:     Pointer(5060),
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(opts *DomainRecordCreateOptions)
+		expectErr string
+	}{
+		{"valid", func(opts *DomainRecordCreateOptions) {}, ""},
+		{"without underscores", func(opts *DomainRecordCreateOptions) {
+			opts.Service = Pointer("xmpp-server")
+			opts.Protocol = Pointer(SRVProtocolUDP)
+		}, ""},
+		{"missing service", func(opts *DomainRecordCreateOptions) { opts.Service = nil }, "requires a service"},
+		{"missing port", func(opts *DomainRecordCreateOptions) { opts.Port = nil }, "requires a port"},
+		{"invalid service", func(opts *DomainRecordCreateOptions) { opts.Service = Pointer("_sip.tcp") }, "service name"},
+		{"invalid protocol", func(opts *DomainRecordCreateOptions) { opts.Protocol = Pointer("_sctp") }, "must be one of"},
+		{"priority out of range", func(opts *DomainRecordCreateOptions) { opts.Priority = Pointer(256) }, "priority"},
+		{"port out of range", func(opts *DomainRecordCreateOptions) { opts.Port = Pointer(70000) }, "port"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := valid
+			test.modify(&opts)
+
+			err := opts.Validate()
+
+			switch {
+			case test.expectErr == "" && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case test.expectErr != "" && (err == nil || !strings.Contains(err.Error(), test.expectErr)):
+				t.Errorf("expected an error containing %q, got %v", test.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestClient_CreateDomainRecord_invalidSRV(t *testing.T) {
+	ts, client := createTestServer(http.MethodPost, "/v4/domains/1/records", "application/json", `{"id": 2}`, http.StatusOK)
+	defer ts.Close()
+
+	if _, err := client.CreateDomainRecord(context.Background(), 1, DomainRecordCreateOptions{
+		Type:   RecordTypeSRV,
+		Target: "sip.example.com",
+	}); err == nil {
+		t.Error("expected the SRV record to be rejected before it is created")
+	}
+
+	if _, err := client.CreateDomainRecord(context.Background(), 1, DomainRecordCreateOptions{
+		Type:   RecordTypeA,
+		Target: "192.0.2.1",
+	}); err != nil {
+		t.Errorf("expected other records not to be validated, got %s", err)
+	}
+}
+
+func TestDomainRecordCreateOptions_Validate_caa(t *testing.T) {
+	tests := []struct {
+		tag    *string
+		target string
+		valid  bool
+	}{
+		{Pointer(CAATagIssue), "letsencrypt.org", true},
+		{Pointer(CAATagIssueWild), "letsencrypt.org; validationmethods=dns-01", true},
+		{Pointer(CAATagIssue), ";", true},
+		{Pointer(CAATagIODEF), "mailto:security@example.com", true},
+		{Pointer(CAATagIODEF), "https://example.com/caa", true},
+		{nil, "letsencrypt.org", false},
+		{Pointer("issues"), "letsencrypt.org", false},
+		{Pointer(CAATagIssue), "https://letsencrypt.org", false},
+		{Pointer(CAATagIssue), "", false},
+		{Pointer(CAATagIODEF), "security@example.com", false},
+	}
+
+	for _, test := range tests {
+		opts := DomainRecordCreateOptions{Type: RecordTypeCAA, Tag: test.tag, Target: test.target}
+
+		if err := opts.Validate(); (err == nil) != test.valid {
+			t.Errorf("tag %v target %q: expected valid to be %t, got %v", test.tag, test.target, test.valid, err)
+		}
+	}
+
+	update := DomainRecordUpdateOptions{Type: RecordTypeCAA, Tag: Pointer("issue-wild")}
+	if err := update.Validate(); err == nil {
+		t.Error("expected an invalid tag to be rejected on update")
+	}
+}
@@ -220,6 +220,17 @@ func (c *Client) ListDomains(ctx context.Context, opts *ListOptions) ([]Domain,
 	return response.Data, nil
 }
 
+// ListDomainsByTag lists the Domains that have been assigned the given tag
+func (c *Client) ListDomainsByTag(ctx context.Context, tag string, opts *ListOptions) ([]Domain, error) {
+	return listByTag(ctx, tag, opts, c.ListDomains)
+}
+
+// GetDomains gets the domains with the provided IDs using as few requests as possible.
+// The results are in the order of ids, with nil entries for domains that were not found.
+func (c *Client) GetDomains(ctx context.Context, ids []int) ([]*Domain, error) {
+	return getByIDs(ctx, ids, c.ListDomains, func(v Domain) int { return v.ID })
+}
+
 // GetDomain gets the domain with the provided ID
 func (c *Client) GetDomain(ctx context.Context, domainID int) (*Domain, error) {
 	req := c.R(ctx).SetResult(&Domain{})
@@ -1,6 +1,8 @@
 package linodego
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -25,6 +27,19 @@ type Error struct {
 	Message  string
 }
 
+// Errors matching the common HTTP status codes returned by the Linode API, for use with errors.Is
+var (
+	ErrBadRequest      = &Error{Code: http.StatusBadRequest, Message: http.StatusText(http.StatusBadRequest)}
+	ErrUnauthorized    = &Error{Code: http.StatusUnauthorized, Message: http.StatusText(http.StatusUnauthorized)}
+	ErrForbidden       = &Error{Code: http.StatusForbidden, Message: http.StatusText(http.StatusForbidden)}
+	ErrNotFound        = &Error{Code: http.StatusNotFound, Message: http.StatusText(http.StatusNotFound)}
+	ErrConflict        = &Error{Code: http.StatusConflict, Message: http.StatusText(http.StatusConflict)}
+	ErrTooManyRequests = &Error{Code: http.StatusTooManyRequests, Message: http.StatusText(http.StatusTooManyRequests)}
+)
+
+// ErrNotSupported is returned for operations the Linode API does not support
+var ErrNotSupported = errors.New("not supported by the Linode API")
+
 // APIErrorReason is an individual invalid request message returned by the Linode API
 type APIErrorReason struct {
 	Reason string `json:"reason"`
@@ -44,11 +59,38 @@ type APIError struct {
 	Errors []APIErrorReason `json:"errors"`
 }
 
+// emptyResponseError builds an Error from the HTTP status of an error response without a body.
+func emptyResponseError(r *resty.Response) Error {
+	return Error{Code: r.StatusCode(), Message: http.StatusText(r.StatusCode()), Response: r.RawResponse}
+}
+
 func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 	if err != nil {
+		if errors.Is(err, ErrClientClosed) {
+			return nil, ErrClientClosed
+		}
+
+		// Cancellation and deadlines are reported with an error wrapping the context's
+		// error rather than as a linodego Error, so they can be matched with errors.Is.
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+
+		// An error response without a body can't be decoded into an APIError,
+		// so report the HTTP status rather than the JSON decoding failure.
+		if r != nil && r.IsError() && len(r.Body()) == 0 {
+			return nil, emptyResponseError(r)
+		}
+
 		return nil, NewError(err)
 	}
 
+	// Successful responses without a body (e.g. 204 No Content) have nothing
+	// to decode and should not be checked for a matching Content-Type.
+	if r.IsSuccess() && len(r.Body()) == 0 {
+		return r, nil
+	}
+
 	if r.Error() != nil {
 		// Check that response is of the correct content-type before unmarshalling
 		expectedContentType := r.Request.Header.Get("Accept")
@@ -61,6 +103,11 @@ func coupleAPIErrors(r *resty.Response, err error) (*resty.Response, error) {
 			return nil, Error{Code: http.StatusBadGateway, Message: http.StatusText(http.StatusBadGateway)}
 		}
 
+		// Error responses without a body have no Content-Type to check
+		if len(r.Body()) == 0 {
+			return nil, emptyResponseError(r)
+		}
+
 		if responseContentType != expectedContentType {
 			msg := fmt.Sprintf(
 				"Unexpected Content-Type: Expected: %v, Received: %v\nResponse body: %s",
@@ -96,6 +143,36 @@ func (g Error) Error() string {
 	return fmt.Sprintf("[%03d] %s", g.Code, g.Message)
 }
 
+// Is reports whether target is an Error with the same Code, so that
+// errors.Is(err, ErrNotFound) matches any Error with a 404 status.
+func (g Error) Is(target error) bool {
+	switch t := target.(type) {
+	case Error:
+		return g.Code == t.Code
+	case *Error:
+		return t != nil && g.Code == t.Code
+	}
+
+	return false
+}
+
+// As sets target to the Error when target is an *Error or **Error, so that
+// errors.As matches both forms regardless of which one was returned.
+func (g Error) As(target any) bool {
+	switch t := target.(type) {
+	case *Error:
+		*t = g
+		return true
+	case **Error:
+		e := g
+		*t = &e
+
+		return true
+	}
+
+	return false
+}
+
 // NewError creates a linodego.Error with a Code identifying the source err type,
 // - ErrorFromString   (1) from a string
 // - ErrorFromError    (2) for an error
@@ -132,3 +209,22 @@ func NewError(err any) *Error {
 		panic(err)
 	}
 }
+
+// asError returns the linodego Error wrapped in err, if any.
+func asError(err error) (*Error, bool) {
+	var linodeErr *Error
+	if errors.As(err, &linodeErr) {
+		return linodeErr, true
+	}
+
+	return nil, false
+}
+
+// errorCode returns the Code of a linodego Error wrapped in err, or 0 if there is none.
+func errorCode(err error) int {
+	if linodeErr, ok := asError(err); ok {
+		return linodeErr.Code
+	}
+
+	return 0
+}
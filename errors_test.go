@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/google/go-cmp/cmp"
@@ -30,6 +32,31 @@ func createTestServer(method, route, contentType, body string, statusCode int) (
 	return ts, &client
 }
 
+// testResponse is a canned JSON response served by createRoutedTestServer.
+type testResponse struct {
+	status int
+	body   string
+}
+
+func createRoutedTestServer(responses map[string]testResponse) (*httptest.Server, *Client) {
+	h := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			rw.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		rw.Header().Add("Content-Type", "application/json")
+		rw.WriteHeader(resp.status)
+		rw.Write([]byte(resp.body))
+	})
+	ts := httptest.NewServer(h)
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	return ts, &client
+}
+
 func TestCoupleAPIErrors_genericHtmlError(t *testing.T) {
 	rawResponse := `<html>
 <head><title>500 Internal Server Error</title></head>
@@ -86,3 +113,107 @@ func TestCoupleAPIErrors_badGatewayError(t *testing.T) {
 		t.Errorf("expected error %#v to match error %#v", err, expectedError)
 	}
 }
+
+func TestCoupleAPIErrors_emptyResponseBody(t *testing.T) {
+	route := "/v4/linode/instances/123/boot"
+
+	for _, statusCode := range []int{http.StatusOK, http.StatusNoContent} {
+		ts, client := createTestServer(http.MethodPost, route, "application/json", "", statusCode)
+
+		if err := client.BootInstance(context.Background(), 123, 0); err != nil {
+			t.Errorf("expected empty %d response to succeed but got: %s", statusCode, err)
+		}
+
+		ts.Close()
+	}
+}
+
+func TestCoupleAPIErrors_emptyErrorBody(t *testing.T) {
+	route := "/v4/linode/instances/123"
+
+	for _, contentType := range []string{"application/json", ""} {
+		t.Run(fmt.Sprintf("content-type %q", contentType), func(t *testing.T) {
+			ts, client := createTestServer(http.MethodDelete, route, contentType, "", http.StatusNotFound)
+			defer ts.Close()
+
+			err := client.DeleteInstance(context.Background(), 123)
+
+			var linodeErr Error
+			if !errors.As(err, &linodeErr) {
+				t.Fatalf("expected linodego.Error but got %#v", err)
+			}
+
+			if linodeErr.Code != http.StatusNotFound {
+				t.Errorf("expected error code %d but got %d", http.StatusNotFound, linodeErr.Code)
+			}
+
+			if linodeErr.Message != http.StatusText(http.StatusNotFound) {
+				t.Errorf("expected error message %q but got %q", http.StatusText(http.StatusNotFound), linodeErr.Message)
+			}
+		})
+	}
+}
+
+func TestCoupleAPIErrors_contextErrors(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryCount(0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.GetInstance(ctx, 123); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a cancelled context error, got %v", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetInstance(ctx, 123); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline exceeded error, got %v", err)
+	}
+}
+
+func TestError_Is(t *testing.T) {
+	wrapped := fmt.Errorf("failed to get instance: %w", &Error{Code: http.StatusNotFound, Message: "Not found"})
+
+	if !errors.Is(wrapped, ErrNotFound) {
+		t.Error("expected a 404 Error to match ErrNotFound")
+	}
+
+	if !errors.Is(Error{Code: http.StatusNotFound}, ErrNotFound) {
+		t.Error("expected a 404 Error value to match ErrNotFound")
+	}
+
+	if errors.Is(wrapped, ErrForbidden) {
+		t.Error("expected a 404 Error to not match ErrForbidden")
+	}
+}
+
+func TestError_As(t *testing.T) {
+	for _, err := range []error{
+		fmt.Errorf("wrapped: %w", &Error{Code: http.StatusConflict}),
+		fmt.Errorf("wrapped: %w", Error{Code: http.StatusConflict}),
+	} {
+		var valueErr Error
+		if !errors.As(err, &valueErr) || valueErr.Code != http.StatusConflict {
+			t.Errorf("expected %v to match an Error value", err)
+		}
+
+		var pointerErr *Error
+		if !errors.As(err, &pointerErr) || pointerErr.Code != http.StatusConflict {
+			t.Errorf("expected %v to match an *Error", err)
+		}
+	}
+}
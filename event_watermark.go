@@ -0,0 +1,112 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// WatermarkStore persists the ID of the latest event an EventPoller has seen,
+// allowing a poller to skip events it has already processed across restarts.
+// Watermarks are keyed by the entity type, entity ID and action the poller watches.
+type WatermarkStore interface {
+	// Get returns the stored event ID for key, and whether one has been stored.
+	Get(ctx context.Context, key string) (eventID int, found bool, err error)
+
+	// Set stores eventID as the watermark for key.
+	Set(ctx context.Context, key string, eventID int) error
+}
+
+// memoryWatermarkStore is the default WatermarkStore, which keeps watermarks in memory.
+type memoryWatermarkStore struct {
+	lock       sync.RWMutex
+	watermarks map[string]int
+}
+
+func newMemoryWatermarkStore() *memoryWatermarkStore {
+	return &memoryWatermarkStore{watermarks: make(map[string]int)}
+}
+
+func (s *memoryWatermarkStore) Get(_ context.Context, key string) (int, bool, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	eventID, found := s.watermarks[key]
+
+	return eventID, found, nil
+}
+
+func (s *memoryWatermarkStore) Set(_ context.Context, key string, eventID int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.watermarks[key] = eventID
+
+	return nil
+}
+
+// SetEventWatermarkStore sets the store used by event pollers to persist the latest event
+// they have seen. Events with an ID at or below the stored watermark are ignored by
+// WaitForLatestUnknownEvent. By default, watermarks are kept in memory.
+// Event pollers use the store of the Client they were created by.
+func (c *Client) SetEventWatermarkStore(store WatermarkStore) *Client {
+	if store == nil {
+		store = newMemoryWatermarkStore()
+	}
+
+	c.eventWatermarkStore = store
+
+	return c
+}
+
+// watermarkKey returns the key the poller's watermark is stored under.
+func (p *EventPoller) watermarkKey() string {
+	return fmt.Sprintf("%s/%v/%s", p.EntityType, p.EntityID, p.Action)
+}
+
+// loadWatermark loads the ID of the latest event seen by a previous poller, if it has
+// not already been loaded. The watermark is loaded once so that events from operations
+// started before the poller was created are not skipped if another poller sees them first.
+func (p *EventPoller) loadWatermark(ctx context.Context) error {
+	if p.watermarkLoaded || p.client.eventWatermarkStore == nil {
+		return nil
+	}
+
+	eventID, found, err := p.client.eventWatermarkStore.Get(ctx, p.watermarkKey())
+	if err != nil {
+		return fmt.Errorf("failed to get event watermark: %w", err)
+	}
+
+	if found {
+		p.watermarkID = eventID
+	}
+
+	p.watermarkLoaded = true
+
+	return nil
+}
+
+// advanceWatermark stores eventID as the watermark if it is newer than the stored one.
+func (p *EventPoller) advanceWatermark(ctx context.Context, eventID int) error {
+	store := p.client.eventWatermarkStore
+	if store == nil {
+		return nil
+	}
+
+	key := p.watermarkKey()
+
+	current, found, err := store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to get event watermark: %w", err)
+	}
+
+	if found && current >= eventID {
+		return nil
+	}
+
+	if err := store.Set(ctx, key, eventID); err != nil {
+		return fmt.Errorf("failed to set event watermark: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEventPoller_watermark(t *testing.T) {
+	polls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		if r.URL.Path != "/v4/account/events" {
+			rw.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		polls++
+		if polls == 1 {
+			rw.Write([]byte(`{"data": [{"id": 2, "status": "finished"}, {"id": 1, "status": "finished"}], "page": 1, "pages": 1, "results": 2}`))
+			return
+		}
+
+		rw.Write([]byte(`{"data": [{"id": 3, "status": "started"}, {"id": 2, "status": "finished"}, {"id": 1, "status": "finished"}], "page": 1, "pages": 1, "results": 3}`))
+	}))
+	defer ts.Close()
+
+	// Simulate a restarted process that has already seen event 2
+	store := newMemoryWatermarkStore()
+	key := "linode/123/linode_boot"
+	if err := store.Set(context.Background(), key, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+	client.SetEventWatermarkStore(store)
+
+	poller, err := client.NewEventPollerWithoutEntity(EntityLinode, ActionLinodeBoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	poller.EntityID = 123
+
+	event, err := poller.WaitForLatestUnknownEvent(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.ID != 3 {
+		t.Errorf("expected event 3, got %d", event.ID)
+	}
+
+	if eventID, found, _ := store.Get(context.Background(), key); !found || eventID != 3 {
+		t.Errorf("expected watermark 3, got %d (found: %t)", eventID, found)
+	}
+}
@@ -2,6 +2,7 @@ package linodego
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 type FilterOperator string
@@ -95,3 +96,117 @@ func Or(order string, orderBy string, nodes ...FilterNode) *Filter {
 func And(order string, orderBy string, nodes ...FilterNode) *Filter {
 	return &Filter{"+and", nodes, orderBy, order}
 }
+
+// withFilterFields returns a copy of opts with the given fields added to its Filter.
+// Any filter already present in opts is preserved; top-level "+and"/"+or" filters
+// are combined with the new fields using "+and". The returned ListOptions shares
+// PageOptions with opts so pagination results are still reported to the caller.
+func withFilterFields(opts *ListOptions, fields map[string]any) (*ListOptions, error) {
+	result := ListOptions{}
+	if opts != nil {
+		result = *opts
+	}
+
+	if result.PageOptions == nil {
+		result.PageOptions = &PageOptions{}
+		if opts != nil {
+			opts.PageOptions = result.PageOptions
+		}
+	}
+
+	filter := make(map[string]any)
+
+	if result.Filter != "" {
+		if err := json.Unmarshal([]byte(result.Filter), &filter); err != nil {
+			return nil, fmt.Errorf("failed to parse existing filter: %w", err)
+		}
+	}
+
+	_, hasAnd := filter["+and"]
+	_, hasOr := filter["+or"]
+
+	if hasAnd || hasOr {
+		combined := map[string]any{}
+		for _, key := range []string{"+order", "+order_by"} {
+			if v, ok := filter[key]; ok {
+				combined[key] = v
+				delete(filter, key)
+			}
+		}
+
+		nodes := []any{filter}
+		for k, v := range fields {
+			nodes = append(nodes, map[string]any{k: v})
+		}
+
+		combined["+and"] = nodes
+		filter = combined
+	} else {
+		for k, v := range fields {
+			filter[k] = v
+		}
+	}
+
+	filterBytes, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Filter = string(filterBytes)
+
+	return &result, nil
+}
+
+// withFilterAnd returns a copy of opts whose Filter also requires each of the given nodes
+// to match, combining them with any filter already present in opts using "+and". Unlike
+// withFilterFields, the same field can be constrained by more than one node, such as a
+// "+gte" and a "+lte" bound. The returned ListOptions shares PageOptions with opts.
+func withFilterAnd(opts *ListOptions, nodes ...map[string]any) (*ListOptions, error) {
+	result := ListOptions{}
+	if opts != nil {
+		result = *opts
+	}
+
+	if result.PageOptions == nil {
+		result.PageOptions = &PageOptions{}
+		if opts != nil {
+			opts.PageOptions = result.PageOptions
+		}
+	}
+
+	filter := make(map[string]any)
+
+	if result.Filter != "" {
+		if err := json.Unmarshal([]byte(result.Filter), &filter); err != nil {
+			return nil, fmt.Errorf("failed to parse existing filter: %w", err)
+		}
+	}
+
+	combined := map[string]any{}
+	for _, key := range []string{"+order", "+order_by"} {
+		if v, ok := filter[key]; ok {
+			combined[key] = v
+			delete(filter, key)
+		}
+	}
+
+	var and []any
+	if len(filter) > 0 {
+		and = append(and, filter)
+	}
+
+	for _, node := range nodes {
+		and = append(and, node)
+	}
+
+	combined["+and"] = and
+
+	filterBytes, err := json.Marshal(combined)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Filter = string(filterBytes)
+
+	return &result, nil
+}
@@ -162,3 +162,54 @@ func TestFilterOr(t *testing.T) {
 		t.Fatal(string(result), " doesn't match ", string(expectedStr))
 	}
 }
+
+func TestWithFilterFields(t *testing.T) {
+	opts := &ListOptions{Filter: `{"label": "cool", "+order_by": "label"}`}
+
+	result, err := withFilterFields(opts, map[string]any{"mine": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var filter map[string]any
+	if err := json.Unmarshal([]byte(result.Filter), &filter); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]any{"label": "cool", "+order_by": "label", "mine": true}
+	if !reflect.DeepEqual(filter, expected) {
+		t.Fatalf("unexpected filter: %s", result.Filter)
+	}
+
+	if opts.Filter != `{"label": "cool", "+order_by": "label"}` {
+		t.Fatalf("original filter was modified: %s", opts.Filter)
+	}
+
+	if result.PageOptions != opts.PageOptions {
+		t.Fatal("expected PageOptions to be shared with the original ListOptions")
+	}
+
+	// Filters using a logical operator are combined with +and
+	opts = &ListOptions{Filter: `{"+or": [{"label": "a"}, {"label": "b"}], "+order": "asc"}`}
+
+	result, err = withFilterFields(opts, map[string]any{"mine": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filter = nil
+	if err := json.Unmarshal([]byte(result.Filter), &filter); err != nil {
+		t.Fatal(err)
+	}
+
+	expected = map[string]any{
+		"+order": "asc",
+		"+and": []any{
+			map[string]any{"+or": []any{map[string]any{"label": "a"}, map[string]any{"label": "b"}}},
+			map[string]any{"mine": true},
+		},
+	}
+	if !reflect.DeepEqual(filter, expected) {
+		t.Fatalf("unexpected filter: %s", result.Filter)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/go-resty/resty/v2"
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
@@ -17,6 +16,10 @@ type FirewallDeviceType string
 const (
 	FirewallDeviceLinode       FirewallDeviceType = "linode"
 	FirewallDeviceNodeBalancer FirewallDeviceType = "nodebalancer"
+
+	// FirewallDeviceLinodeInterface devices are the public and VPC LinodeInterfaces of Instances
+	// using the Linode Interfaces networking model
+	FirewallDeviceLinodeInterface FirewallDeviceType = "linode_interface"
 )
 
 // FirewallDevice represents a device governed by a Firewall
@@ -68,30 +71,10 @@ type FirewallDevicesPagedResponse struct {
 	Data []FirewallDevice `json:"data"`
 }
 
-// endpoint gets the endpoint URL for FirewallDevices of a given Firewall
-func (FirewallDevicesPagedResponse) endpoint(ids ...any) string {
-	id, _ := ids[0].(int)
-	return fmt.Sprintf("networking/firewalls/%d/devices", id)
-}
-
-func (resp *FirewallDevicesPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(FirewallDevicesPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*FirewallDevicesPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListFirewallDevices get devices associated with a given Firewall
 func (c *Client) ListFirewallDevices(ctx context.Context, firewallID int, opts *ListOptions) ([]FirewallDevice, error) {
-	response := FirewallDevicesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, firewallID)
-	if err != nil {
-		return nil, err
-	}
-	return response.Data, nil
+	e := fmt.Sprintf("networking/firewalls/%d/devices", firewallID)
+	return listPaginated[FirewallDevice](ctx, c, e, opts)
 }
 
 // GetFirewallDevice gets a FirewallDevice given an ID
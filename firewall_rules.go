@@ -3,7 +3,11 @@ package linodego
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // NetworkProtocol enum type
@@ -39,6 +43,130 @@ type FirewallRuleSet struct {
 	InboundPolicy  string         `json:"inbound_policy"`
 	Outbound       []FirewallRule `json:"outbound"`
 	OutboundPolicy string         `json:"outbound_policy"`
+
+	// Fingerprint and Version identify the revision of the rules returned by the API.
+	// They are read-only and are not sent by UpdateFirewallRules.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Version     int    `json:"version,omitempty"`
+}
+
+// ErrFirewallRulesChanged is returned by UpdateFirewallRulesIfUnchanged when the rules
+// of the Firewall have been changed since they were read.
+var ErrFirewallRulesChanged = errors.New("firewall rules have changed since they were read")
+
+// Validate checks the rule for mistakes that the API would reject: an unknown Protocol,
+// ports on an ICMP or IPENCAP rule, or a malformed Ports string. Ports must be a
+// comma-separated list of ports or ranges between 1 and 65535, e.g. "22,80,8000-9000".
+func (r FirewallRule) Validate() error {
+	switch r.Protocol {
+	case TCP, UDP:
+		if _, err := ParsePortRange(r.Ports); err != nil {
+			return fmt.Errorf("firewall rule %q: %w", r.Label, err)
+		}
+	case ICMP, IPENCAP:
+		if r.Ports != "" {
+			return fmt.Errorf("firewall rule %q: ports can not be specified for %s rules", r.Label, r.Protocol)
+		}
+	default:
+		return fmt.Errorf("firewall rule %q: unknown protocol %q", r.Label, r.Protocol)
+	}
+
+	return nil
+}
+
+// Validate checks each of the inbound and outbound rules of the rule set, see FirewallRule.Validate.
+func (rs FirewallRuleSet) Validate() error {
+	for _, rule := range append(append([]FirewallRule{}, rs.Inbound...), rs.Outbound...) {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PortSpan is an inclusive range of ports. A single port has the same From and To.
+type PortSpan struct {
+	From int
+	To   int
+}
+
+// ParsePortRange parses a firewall rule Ports string, a comma-separated list of ports
+// or ranges between 1 and 65535 such as "22,80,8000-9000". An empty string has no spans.
+func ParsePortRange(ports string) ([]PortSpan, error) {
+	if ports == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(ports, ",")
+	spans := make([]PortSpan, len(parts))
+
+	for i, part := range parts {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+
+		values := make([]int, len(bounds))
+		for j, bound := range bounds {
+			value, err := strconv.Atoi(bound)
+			if err != nil || value < 1 || value > 65535 {
+				return nil, fmt.Errorf("invalid port %q in %q", bound, ports)
+			}
+
+			values[j] = value
+		}
+
+		spans[i] = PortSpan{From: values[0], To: values[len(values)-1]}
+
+		if len(values) == 2 && values[0] >= values[1] {
+			return nil, fmt.Errorf("invalid port range %q in %q", part, ports)
+		}
+	}
+
+	return spans, nil
+}
+
+// FormatPortRange formats spans as a firewall rule Ports string. The spans are sorted,
+// and overlapping or adjacent spans are merged, e.g. 80, 81-90 and 443 become "80-90,443".
+func FormatPortRange(spans []PortSpan) string {
+	sorted := make([]PortSpan, 0, len(spans))
+
+	for _, span := range spans {
+		if span.From > span.To {
+			span.From, span.To = span.To, span.From
+		}
+
+		sorted = append(sorted, span)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].From < sorted[j].From
+	})
+
+	var merged []PortSpan
+
+	for _, span := range sorted {
+		if last := len(merged) - 1; last >= 0 && span.From <= merged[last].To+1 {
+			if span.To > merged[last].To {
+				merged[last].To = span.To
+			}
+
+			continue
+		}
+
+		merged = append(merged, span)
+	}
+
+	parts := make([]string, len(merged))
+
+	for i, span := range merged {
+		if span.From == span.To {
+			parts[i] = strconv.Itoa(span.From)
+			continue
+		}
+
+		parts[i] = fmt.Sprintf("%d-%d", span.From, span.To)
+	}
+
+	return strings.Join(parts, ",")
 }
 
 // GetFirewallRules gets the FirewallRuleSet for the given Firewall.
@@ -54,6 +182,9 @@ func (c *Client) GetFirewallRules(ctx context.Context, firewallID int) (*Firewal
 
 // UpdateFirewallRules updates the FirewallRuleSet for the given Firewall
 func (c *Client) UpdateFirewallRules(ctx context.Context, firewallID int, rules FirewallRuleSet) (*FirewallRuleSet, error) {
+	rules.Fingerprint = ""
+	rules.Version = 0
+
 	body, err := json.Marshal(rules)
 	if err != nil {
 		return nil, err
@@ -67,3 +198,25 @@ func (c *Client) UpdateFirewallRules(ctx context.Context, firewallID int, rules
 	}
 	return r.Result().(*FirewallRuleSet), nil
 }
+
+// UpdateFirewallRulesIfUnchanged updates the FirewallRuleSet for the given Firewall only if its
+// rules are still at the revision identified by the Fingerprint and Version of rules, as returned
+// by GetFirewallRules. An error wrapping ErrFirewallRulesChanged is returned otherwise, so that the
+// rules can be read and modified again.
+// The API does not support conditional writes, so the revision is checked immediately before
+// updating; a concurrent change made between the check and the update is not detected.
+func (c *Client) UpdateFirewallRulesIfUnchanged(ctx context.Context, firewallID int, rules FirewallRuleSet) (*FirewallRuleSet, error) {
+	current, err := c.GetFirewallRules(ctx, firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Fingerprint != rules.Fingerprint || current.Version != rules.Version {
+		return nil, fmt.Errorf(
+			"%w: firewall %d is at version %d, expected version %d",
+			ErrFirewallRulesChanged, firewallID, current.Version, rules.Version,
+		)
+	}
+
+	return c.UpdateFirewallRules(ctx, firewallID, rules)
+}
@@ -0,0 +1,118 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFirewallRule_Validate(t *testing.T) {
+	tests := []struct {
+		rule FirewallRule
+		err  string
+	}{
+		{rule: FirewallRule{Protocol: TCP, Ports: "80"}},
+		{rule: FirewallRule{Protocol: TCP, Ports: "22,80,443,8000-9000"}},
+		{rule: FirewallRule{Protocol: UDP}},
+		{rule: FirewallRule{Protocol: ICMP}},
+		{rule: FirewallRule{Protocol: ICMP, Ports: "80"}, err: "ports can not be specified for ICMP rules"},
+		{rule: FirewallRule{Protocol: TCP, Ports: "80,"}, err: `invalid port ""`},
+		{rule: FirewallRule{Protocol: TCP, Ports: "0-1024"}, err: `invalid port "0"`},
+		{rule: FirewallRule{Protocol: TCP, Ports: "65536"}, err: `invalid port "65536"`},
+		{rule: FirewallRule{Protocol: TCP, Ports: "1024-80"}, err: `invalid port range "1024-80"`},
+		{rule: FirewallRule{Protocol: "SCTP"}, err: `unknown protocol "SCTP"`},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate()
+
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("expected %+v to be valid, got %v", test.rule, err)
+		case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("expected %+v to fail with %q, got %v", test.rule, test.err, err)
+		}
+	}
+}
+
+func TestParsePortRange(t *testing.T) {
+	spans, err := ParsePortRange("22, 80,8000-9000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []PortSpan{{22, 22}, {80, 80}, {8000, 9000}}
+	if diff := cmp.Diff(expected, spans); diff != "" {
+		t.Errorf("unexpected spans: %s", diff)
+	}
+
+	if _, err := ParsePortRange("80-"); err == nil {
+		t.Error("expected an incomplete range to be invalid")
+	}
+}
+
+func TestFormatPortRange(t *testing.T) {
+	formatted := FormatPortRange([]PortSpan{{443, 443}, {81, 90}, {80, 80}, {85, 88}, {22, 22}})
+	if expected := "22,80-90,443"; formatted != expected {
+		t.Errorf("expected %s but got %s", expected, formatted)
+	}
+
+	if formatted := FormatPortRange(nil); formatted != "" {
+		t.Errorf("expected no spans to format as an empty string, got %s", formatted)
+	}
+}
+
+func TestClient_UpdateFirewallRulesIfUnchanged(t *testing.T) {
+	var updated string
+
+	version := 1
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			rw.Write([]byte(fmt.Sprintf(`{"inbound_policy": "DROP", "fingerprint": "fp-%d", "version": %d}`, version, version)))
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			updated = string(body)
+			version++
+
+			rw.Write([]byte(fmt.Sprintf(`{"inbound_policy": "ACCEPT", "fingerprint": "fp-%d", "version": %d}`, version, version)))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	rules, err := client.GetFirewallRules(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules.Fingerprint != "fp-1" || rules.Version != 1 {
+		t.Fatalf("unexpected revision: %s %d", rules.Fingerprint, rules.Version)
+	}
+
+	rules.InboundPolicy = "ACCEPT"
+
+	if _, err := client.UpdateFirewallRulesIfUnchanged(context.Background(), 123, *rules); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(updated, "fingerprint") || strings.Contains(updated, "version") {
+		t.Errorf("expected the revision to not be sent, got %s", updated)
+	}
+
+	if _, err := client.UpdateFirewallRulesIfUnchanged(context.Background(), 123, *rules); !errors.Is(err, ErrFirewallRulesChanged) {
+		t.Errorf("expected ErrFirewallRulesChanged for a stale revision, got %v", err)
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -21,33 +25,43 @@ const (
 	ImageStatusAvailable     ImageStatus = "available"
 )
 
+// ImageCapabilityCloudInit is the capability reported by Images that support cloud-init
+const ImageCapabilityCloudInit = "cloud-init"
+
 // Image represents a deployable Image object for use with Linode Instances
 type Image struct {
-	ID          string      `json:"id"`
-	CreatedBy   string      `json:"created_by"`
-	Label       string      `json:"label"`
-	Description string      `json:"description"`
-	Type        string      `json:"type"`
-	Vendor      string      `json:"vendor"`
-	Status      ImageStatus `json:"status"`
-	Size        int         `json:"size"`
-	IsPublic    bool        `json:"is_public"`
-	Deprecated  bool        `json:"deprecated"`
-	Created     *time.Time  `json:"-"`
-	Expiry      *time.Time  `json:"-"`
+	ID           string      `json:"id"`
+	CreatedBy    string      `json:"created_by"`
+	Label        string      `json:"label"`
+	Description  string      `json:"description"`
+	Type         string      `json:"type"`
+	Vendor       string      `json:"vendor"`
+	Status       ImageStatus `json:"status"`
+	Size         int         `json:"size"`
+	IsPublic     bool        `json:"is_public"`
+	Deprecated   bool        `json:"deprecated"`
+	Capabilities []string    `json:"capabilities"`
+	Tags         []string    `json:"tags"`
+	Created      *time.Time  `json:"-"`
+	Updated      *time.Time  `json:"-"`
+	Expiry       *time.Time  `json:"-"`
+	EOL          *time.Time  `json:"-"`
 }
 
 // ImageCreateOptions fields are those accepted by CreateImage
 type ImageCreateOptions struct {
-	DiskID      int    `json:"disk_id"`
-	Label       string `json:"label"`
-	Description string `json:"description,omitempty"`
+	DiskID      int      `json:"disk_id"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	CloudInit   bool     `json:"cloud_init,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
 }
 
 // ImageUpdateOptions fields are those accepted by UpdateImage
 type ImageUpdateOptions struct {
-	Label       string  `json:"label,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Label       string    `json:"label,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
 }
 
 // ImageCreateUploadResponse fields are those returned by CreateImageUpload
@@ -58,16 +72,20 @@ type ImageCreateUploadResponse struct {
 
 // ImageCreateUploadOptions fields are those accepted by CreateImageUpload
 type ImageCreateUploadOptions struct {
-	Region      string `json:"region"`
-	Label       string `json:"label"`
-	Description string `json:"description,omitempty"`
+	Region      string   `json:"region"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	CloudInit   bool     `json:"cloud_init,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
 }
 
 // ImageUploadOptions fields are those accepted by UploadImage
 type ImageUploadOptions struct {
-	Region      string `json:"region"`
-	Label       string `json:"label"`
-	Description string `json:"description,omitempty"`
+	Region      string   `json:"region"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	CloudInit   bool     `json:"cloud_init,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
 	Image       io.Reader
 }
 
@@ -78,7 +96,9 @@ func (i *Image) UnmarshalJSON(b []byte) error {
 	p := struct {
 		*Mask
 		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
 		Expiry  *parseabletime.ParseableTime `json:"expiry"`
+		EOL     *parseabletime.ParseableTime `json:"eol"`
 	}{
 		Mask: (*Mask)(i),
 	}
@@ -88,15 +108,29 @@ func (i *Image) UnmarshalJSON(b []byte) error {
 	}
 
 	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
 	i.Expiry = (*time.Time)(p.Expiry)
+	i.EOL = (*time.Time)(p.EOL)
 
 	return nil
 }
 
+// HasCapability returns true if the Image reports the given capability
+func (i Image) HasCapability(capability string) bool {
+	for _, c := range i.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetUpdateOptions converts an Image to ImageUpdateOptions for use in UpdateImage
 func (i Image) GetUpdateOptions() (iu ImageUpdateOptions) {
 	iu.Label = i.Label
 	iu.Description = copyString(&i.Description)
+	iu.Tags = &i.Tags
 	return
 }
 
@@ -130,6 +164,147 @@ func (c *Client) ListImages(ctx context.Context, opts *ListOptions) ([]Image, er
 	return response.Data, nil
 }
 
+// ListDistributions lists the public distribution Images provided by Linode
+func (c *Client) ListDistributions(ctx context.Context, opts *ListOptions) ([]Image, error) {
+	return c.listImagesWithFields(ctx, opts, map[string]any{"is_public": true})
+}
+
+// ListPrivateImages lists the Images owned by the Account
+func (c *Client) ListPrivateImages(ctx context.Context, opts *ListOptions) ([]Image, error) {
+	return c.listImagesWithFields(ctx, opts, map[string]any{"is_public": false})
+}
+
+// ListSupportedDistributions lists the public distribution Images that are neither deprecated
+// nor past their end of life
+func (c *Client) ListSupportedDistributions(ctx context.Context, opts *ListOptions) ([]Image, error) {
+	images, err := c.listImagesWithFields(ctx, opts, map[string]any{"is_public": true, "deprecated": false})
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterSupportedImages(images, time.Now()), nil
+}
+
+func (c *Client) listImagesWithFields(ctx context.Context, opts *ListOptions, fields map[string]any) ([]Image, error) {
+	opts, err := withFilterFields(opts, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListImages(ctx, opts)
+}
+
+// IsEOL returns true if the Image has reached its end of life at the given time
+func (i Image) IsEOL(at time.Time) bool {
+	return i.EOL != nil && !i.EOL.After(at)
+}
+
+// FilterSupportedImages returns the images that are not deprecated and have not
+// reached their end of life at the given time
+func FilterSupportedImages(images []Image, at time.Time) []Image {
+	result := make([]Image, 0, len(images))
+
+	for _, image := range images {
+		if image.Deprecated || image.IsEOL(at) {
+			continue
+		}
+
+		result = append(result, image)
+	}
+
+	return result
+}
+
+// imageVersionRegexp matches the version number in the label of a distribution Image
+var imageVersionRegexp = regexp.MustCompile(`\d+(\.\d+)*`)
+
+// imageVersion returns the components of the version in the Image's label, e.g. [22 4] for
+// "Ubuntu 22.04 LTS", or nil if the label has no version.
+func imageVersion(image Image) []int {
+	match := imageVersionRegexp.FindString(image.Label)
+	if match == "" {
+		return nil
+	}
+
+	parts := strings.Split(match, ".")
+	version := make([]int, len(parts))
+
+	for i, part := range parts {
+		version[i], _ = strconv.Atoi(part)
+	}
+
+	return version
+}
+
+// compareImageVersions compares the versions of two Images, falling back to their
+// creation time for equal versions. Images without a version sort first.
+func compareImageVersions(a, b Image) int {
+	va, vb := imageVersion(a), imageVersion(b)
+
+	for i := 0; i < len(va) && i < len(vb); i++ {
+		if result := compareOrdered(va[i], vb[i]); result != 0 {
+			return result
+		}
+	}
+
+	if result := compareOrdered(len(va), len(vb)); result != 0 {
+		return result
+	}
+
+	switch {
+	case a.Created == nil && b.Created == nil:
+		return 0
+	case a.Created == nil:
+		return -1
+	case b.Created == nil:
+		return 1
+	}
+
+	return compareOrdered(a.Created.UnixNano(), b.Created.UnixNano())
+}
+
+// LatestImageForOS returns the newest supported distribution Image from the given vendor
+// (e.g. "Ubuntu" or "Debian"), as determined by the version in its label.
+func (c *Client) LatestImageForOS(ctx context.Context, vendor string) (*Image, error) {
+	return c.latestImageForOS(ctx, vendor, false)
+}
+
+// LatestLTSImageForOS returns the newest supported long-term support distribution Image from
+// the given vendor, ignoring interim releases such as Ubuntu 23.10.
+func (c *Client) LatestLTSImageForOS(ctx context.Context, vendor string) (*Image, error) {
+	return c.latestImageForOS(ctx, vendor, true)
+}
+
+func (c *Client) latestImageForOS(ctx context.Context, vendor string, ltsOnly bool) (*Image, error) {
+	opts, err := withFilterFields(nil, map[string]any{"vendor": vendor})
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := c.ListSupportedDistributions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *Image
+
+	for i, image := range images {
+		if ltsOnly && !strings.Contains(image.Label, "LTS") {
+			continue
+		}
+
+		if latest == nil || compareImageVersions(image, *latest) > 0 {
+			latest = &images[i]
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("no supported images were found for %s", vendor)
+	}
+
+	return latest, nil
+}
+
 // GetImage gets the Image with the provided ID
 func (c *Client) GetImage(ctx context.Context, imageID string) (*Image, error) {
 	e := fmt.Sprintf("images/%s", imageID)
@@ -157,6 +332,51 @@ func (c *Client) CreateImage(ctx context.Context, opts ImageCreateOptions) (*Ima
 	return r.Result().(*Image), nil
 }
 
+// ValidateImageCreateOptions checks that the source disk of the given Linode is
+// compatible with the provided ImageCreateOptions before an Image is created.
+// When CloudInit is requested, the disk must be a filesystem disk. This is a best-effort
+// check: the API does not report which Image a disk was deployed from, so the Image the
+// Linode was last deployed from is used as a stand-in. An error is only returned for it if
+// that Image still exists and lacks the cloud-init capability.
+func (c *Client) ValidateImageCreateOptions(ctx context.Context, linodeID int, opts ImageCreateOptions) error {
+	if !opts.CloudInit {
+		return nil
+	}
+
+	disk, err := c.GetInstanceDisk(ctx, linodeID, opts.DiskID)
+	if err != nil {
+		return err
+	}
+
+	if disk.Filesystem == FilesystemSwap || disk.Filesystem == FilesystemRaw {
+		return fmt.Errorf("cloud-init was requested but disk %d has unsupported filesystem %s", disk.ID, disk.Filesystem)
+	}
+
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return err
+	}
+
+	if instance.Image == "" {
+		return nil
+	}
+
+	image, err := c.GetImage(ctx, instance.Image)
+	if err != nil {
+		if errorCode(err) == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get source image %s: %w", instance.Image, err)
+	}
+
+	if !image.HasCapability(ImageCapabilityCloudInit) {
+		return fmt.Errorf("cloud-init was requested but source image %s does not support cloud-init", image.ID)
+	}
+
+	return nil
+}
+
 // UpdateImage updates the Image with the specified id
 func (c *Client) UpdateImage(ctx context.Context, imageID string, opts ImageUpdateOptions) (*Image, error) {
 	body, err := json.Marshal(opts)
@@ -223,6 +443,8 @@ func (c *Client) UploadImage(ctx context.Context, opts ImageUploadOptions) (*Ima
 		Label:       opts.Label,
 		Region:      opts.Region,
 		Description: opts.Description,
+		CloudInit:   opts.CloudInit,
+		Tags:        opts.Tags,
 	})
 	if err != nil {
 		return nil, err
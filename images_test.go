@@ -0,0 +1,103 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_ValidateImageCreateOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		imageStatus int
+		imageBody   string
+		expectErr   bool
+	}{
+		{"cloud-init image", http.StatusOK, `{"id": "linode/debian11", "capabilities": ["cloud-init"]}`, false},
+		{"image without cloud-init", http.StatusOK, `{"id": "linode/debian11", "capabilities": []}`, true},
+		{"deleted image", http.StatusNotFound, `{"errors": [{"reason": "Not found"}]}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, client := createRoutedTestServer(map[string]testResponse{
+				"/v4/linode/instances/123/disks/456": {http.StatusOK, `{"id": 456, "filesystem": "ext4"}`},
+				"/v4/linode/instances/123":           {http.StatusOK, `{"id": 123, "image": "linode/debian11"}`},
+				"/v4/images/linode/debian11":         {tt.imageStatus, tt.imageBody},
+			})
+			defer ts.Close()
+
+			err := client.ValidateImageCreateOptions(context.Background(), 123, ImageCreateOptions{DiskID: 456, CloudInit: true})
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestClient_ListSupportedDistributions(t *testing.T) {
+	var filter string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		filter = r.Header.Get("X-Filter")
+
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [
+			{"id": "linode/debian12", "is_public": true, "eol": "2028-06-01T04:00:00"},
+			{"id": "linode/debian9", "is_public": true, "eol": "2022-06-30T04:00:00"}
+		], "page": 1, "pages": 1, "results": 2}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	images, err := client.ListSupportedDistributions(context.Background(), NewListOptions(0, `{"vendor": "Debian"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(images) != 1 || images[0].ID != "linode/debian12" {
+		t.Errorf("expected the EOL image to be filtered out, got %+v", images)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(filter), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded["is_public"] != true || decoded["deprecated"] != false || decoded["vendor"] != "Debian" {
+		t.Errorf("unexpected filter: %s", filter)
+	}
+}
+
+func TestClient_LatestImageForOS(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/images", "application/json", `{"data": [
+		{"id": "linode/ubuntu20.04", "label": "Ubuntu 20.04 LTS", "vendor": "Ubuntu", "is_public": true},
+		{"id": "linode/ubuntu22.04", "label": "Ubuntu 22.04 LTS", "vendor": "Ubuntu", "is_public": true},
+		{"id": "linode/ubuntu23.10", "label": "Ubuntu 23.10", "vendor": "Ubuntu", "is_public": true},
+		{"id": "linode/ubuntu24.04", "label": "Ubuntu 24.04 LTS", "vendor": "Ubuntu", "is_public": true, "deprecated": true},
+		{"id": "linode/ubuntu22.10", "label": "Ubuntu 22.10", "vendor": "Ubuntu", "is_public": true}
+	], "page": 1, "pages": 1, "results": 5}`, http.StatusOK)
+	defer ts.Close()
+
+	image, err := client.LatestImageForOS(context.Background(), "Ubuntu")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if image.ID != "linode/ubuntu23.10" {
+		t.Errorf("expected the latest release, got %s", image.ID)
+	}
+
+	image, err = client.LatestLTSImageForOS(context.Background(), "Ubuntu")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if image.ID != "linode/ubuntu22.04" {
+		t.Errorf("expected the latest LTS release, got %s", image.ID)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/go-resty/resty/v2"
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
@@ -61,6 +60,7 @@ type ConfigInterfacePurpose string
 const (
 	InterfacePurposePublic ConfigInterfacePurpose = "public"
 	InterfacePurposeVLAN   ConfigInterfacePurpose = "vlan"
+	InterfacePurposeVPC    ConfigInterfacePurpose = "vpc"
 )
 
 // InstanceConfigInterface contains information about a configuration's network interface
@@ -68,6 +68,39 @@ type InstanceConfigInterface struct {
 	IPAMAddress string                 `json:"ipam_address"`
 	Label       string                 `json:"label"`
 	Purpose     ConfigInterfacePurpose `json:"purpose"`
+	Primary     bool                   `json:"primary,omitempty"`
+
+	// SubnetID, IPv4 and IPRanges are only set on VPC interfaces
+	SubnetID *int                         `json:"subnet_id,omitempty"`
+	IPv4     *InstanceConfigInterfaceIPv4 `json:"ipv4,omitempty"`
+	IPRanges []string                     `json:"ip_ranges,omitempty"`
+}
+
+// InstanceConfigInterfaceIPv4 contains the IPv4 addresses of a VPC interface. VPC is the address
+// in the VPC subnet, and NAT1To1 the public address it is translated to, or "any" when creating the
+// interface to have one assigned. The VPC address is assigned by the API if it is not set.
+type InstanceConfigInterfaceIPv4 struct {
+	VPC     string  `json:"vpc,omitempty"`
+	NAT1To1 *string `json:"nat_1_1,omitempty"`
+}
+
+// copy returns a copy of the interface that shares no state with it
+func (i InstanceConfigInterface) copy() InstanceConfigInterface {
+	result := i
+	result.SubnetID = copyInt(i.SubnetID)
+
+	if i.IPv4 != nil {
+		ipv4 := *i.IPv4
+		ipv4.NAT1To1 = copyString(i.IPv4.NAT1To1)
+		result.IPv4 = &ipv4
+	}
+
+	if i.IPRanges != nil {
+		result.IPRanges = make([]string, len(i.IPRanges))
+		copy(result.IPRanges, i.IPRanges)
+	}
+
+	return result
 }
 
 // InstanceConfigsPagedResponse represents a paginated InstanceConfig API response
@@ -130,27 +163,78 @@ func (i *InstanceConfig) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// GetCreateOptions converts a InstanceConfig to InstanceConfigCreateOptions for use in CreateInstanceConfig
+// GetCreateOptions converts a InstanceConfig to InstanceConfigCreateOptions for use in CreateInstanceConfig.
+// The devices, helpers and interfaces are copied, so the result can be modified without
+// affecting the InstanceConfig.
 func (i InstanceConfig) GetCreateOptions() InstanceConfigCreateOptions {
 	initrd := 0
 	if i.InitRD != nil {
 		initrd = *i.InitRD
 	}
+
+	var devices InstanceConfigDeviceMap
+	if i.Devices != nil {
+		devices = i.Devices.copy()
+	}
+
+	var helpers *InstanceConfigHelpers
+	if i.Helpers != nil {
+		h := *i.Helpers
+		helpers = &h
+	}
+
+	var rootDevice *string
+	if i.RootDevice != "" {
+		rootDevice = copyString(&i.RootDevice)
+	}
+
+	// An empty list of interfaces is kept empty rather than nil, as it is sent as null otherwise
+	var interfaces []InstanceConfigInterface
+	if i.Interfaces != nil {
+		interfaces = make([]InstanceConfigInterface, len(i.Interfaces))
+		for j, iface := range i.Interfaces {
+			interfaces[j] = iface.copy()
+		}
+	}
+
 	return InstanceConfigCreateOptions{
 		Label:       i.Label,
 		Comments:    i.Comments,
-		Devices:     *i.Devices,
-		Helpers:     i.Helpers,
-		Interfaces:  i.Interfaces,
+		Devices:     devices,
+		Helpers:     helpers,
+		Interfaces:  interfaces,
 		MemoryLimit: i.MemoryLimit,
 		Kernel:      i.Kernel,
 		InitRD:      initrd,
-		RootDevice:  copyString(&i.RootDevice),
+		RootDevice:  rootDevice,
 		RunLevel:    i.RunLevel,
 		VirtMode:    i.VirtMode,
 	}
 }
 
+// copy returns a copy of the device map that shares none of its devices
+func (m InstanceConfigDeviceMap) copy() InstanceConfigDeviceMap {
+	copyDevice := func(d *InstanceConfigDevice) *InstanceConfigDevice {
+		if d == nil {
+			return nil
+		}
+
+		result := *d
+		return &result
+	}
+
+	return InstanceConfigDeviceMap{
+		SDA: copyDevice(m.SDA),
+		SDB: copyDevice(m.SDB),
+		SDC: copyDevice(m.SDC),
+		SDD: copyDevice(m.SDD),
+		SDE: copyDevice(m.SDE),
+		SDF: copyDevice(m.SDF),
+		SDG: copyDevice(m.SDG),
+		SDH: copyDevice(m.SDH),
+	}
+}
+
 // GetUpdateOptions converts a InstanceConfig to InstanceConfigUpdateOptions for use in UpdateInstanceConfig
 func (i InstanceConfig) GetUpdateOptions() InstanceConfigUpdateOptions {
 	return InstanceConfigUpdateOptions{
@@ -168,30 +252,10 @@ func (i InstanceConfig) GetUpdateOptions() InstanceConfigUpdateOptions {
 	}
 }
 
-// endpoint gets the endpoint URL for InstanceConfigs of a given Instance
-func (InstanceConfigsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("linode/instances/%d/configs", id)
-}
-
-func (resp *InstanceConfigsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(InstanceConfigsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*InstanceConfigsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListInstanceConfigs lists InstanceConfigs
 func (c *Client) ListInstanceConfigs(ctx context.Context, linodeID int, opts *ListOptions) ([]InstanceConfig, error) {
-	response := InstanceConfigsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, linodeID)
-	if err != nil {
-		return nil, err
-	}
-	return response.Data, nil
+	e := fmt.Sprintf("linode/instances/%d/configs", linodeID)
+	return listPaginated[InstanceConfig](ctx, c, e, opts)
 }
 
 // GetInstanceConfig gets the template with the provided ID
@@ -222,6 +286,20 @@ func (c *Client) CreateInstanceConfig(ctx context.Context, linodeID int, opts In
 	return r.Result().(*InstanceConfig), nil
 }
 
+// ExportInstanceConfig returns the settings of an InstanceConfig as InstanceConfigCreateOptions,
+// which can be modified and passed to CreateInstanceConfig to create a copy of the config on
+// this or another Instance. Devices refer to the disks and volumes of the original Instance,
+// so they must be replaced when the config is created on another Instance.
+func (c *Client) ExportInstanceConfig(ctx context.Context, linodeID int, configID int) (*InstanceConfigCreateOptions, error) {
+	config, err := c.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := config.GetCreateOptions()
+	return &opts, nil
+}
+
 // UpdateInstanceConfig update an InstanceConfig for the given Instance
 func (c *Client) UpdateInstanceConfig(ctx context.Context, linodeID int, configID int, opts InstanceConfigUpdateOptions) (*InstanceConfig, error) {
 	body, err := json.Marshal(opts)
@@ -250,3 +328,63 @@ func (c *Client) DeleteInstanceConfig(ctx context.Context, linodeID int, configI
 	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
 	return err
 }
+
+// GetInstanceBootConfig returns the config the Instance boots with when BootInstance or
+// RebootInstance is called without a config ID. This is the config of the most recent
+// boot or reboot event of the Instance, falling back to the Instance's first config if
+// there is no such event in the latest page of events or its config no longer exists.
+func (c *Client) GetInstanceBootConfig(ctx context.Context, linodeID int) (*InstanceConfig, error) {
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("instance %d has no configs", linodeID)
+	}
+
+	f := Filter{
+		OrderBy: "created",
+		Order:   Descending,
+	}
+	f.AddField(Eq, "entity.type", EntityLinode)
+	f.AddField(Eq, "entity.id", linodeID)
+
+	filter, err := f.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := c.ListEvents(ctx, &ListOptions{
+		Filter:      string(filter),
+		PageOptions: &PageOptions{Page: 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	for _, event := range events {
+		if (event.Action != ActionLinodeBoot && event.Action != ActionLinodeReboot) || event.Status == EventFailed {
+			continue
+		}
+
+		if event.SecondaryEntity == nil || event.SecondaryEntity.Type != EntityLinodeConfig {
+			continue
+		}
+
+		configID, ok := event.SecondaryEntity.ID.(float64)
+		if !ok {
+			continue
+		}
+
+		for i := range configs {
+			if configs[i].ID == int(configID) {
+				return &configs[i], nil
+			}
+		}
+
+		break
+	}
+
+	return &configs[0], nil
+}
@@ -0,0 +1,126 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetInstanceBootConfig(t *testing.T) {
+	configs := `{"data": [{"id": 1, "label": "primary"}, {"id": 2, "label": "rescue"}], "page": 1, "pages": 1, "results": 2}`
+
+	tests := []struct {
+		name     string
+		events   string
+		expected int
+	}{
+		{
+			name: "last boot",
+			events: `{"data": [
+				{"id": 12, "action": "linode_boot", "status": "failed", "secondary_entity": {"id": 1, "type": "linode_config"}},
+				{"id": 11, "action": "linode_reboot", "status": "finished", "secondary_entity": {"id": 2, "type": "linode_config"}},
+				{"id": 10, "action": "linode_boot", "status": "finished", "secondary_entity": {"id": 1, "type": "linode_config"}}
+			], "page": 1, "pages": 1, "results": 3}`,
+			expected: 2,
+		},
+		{
+			name:     "never booted",
+			events:   `{"data": [{"id": 10, "action": "linode_create", "status": "finished"}], "page": 1, "pages": 1, "results": 1}`,
+			expected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts, client := createRoutedTestServer(map[string]testResponse{
+				"/v4/linode/instances/123/configs": {http.StatusOK, configs},
+				"/v4/account/events":               {http.StatusOK, test.events},
+			})
+			defer ts.Close()
+
+			config, err := client.GetInstanceBootConfig(context.Background(), 123)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if config.ID != test.expected {
+				t.Errorf("expected config %d but got %d", test.expected, config.ID)
+			}
+		})
+	}
+}
+
+func TestClient_ExportInstanceConfig(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123/configs/456", "application/json", `{
+		"id": 456,
+		"label": "web",
+		"kernel": "linode/grub2",
+		"root_device": "/dev/sda",
+		"init_rd": null,
+		"devices": {"sda": {"disk_id": 1}, "sdb": {"volume_id": 2}},
+		"helpers": {"network": true},
+		"interfaces": [{"purpose": "public"}, {"purpose": "vlan", "label": "backend", "ipam_address": "10.0.0.1/24"}]
+	}`, http.StatusOK)
+	defer ts.Close()
+
+	opts, err := client.ExportInstanceConfig(context.Background(), 123, 456)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if opts.Label != "web" || opts.Kernel != "linode/grub2" || opts.RootDevice == nil || *opts.RootDevice != "/dev/sda" {
+		t.Errorf("unexpected settings: %+v", opts)
+	}
+
+	if opts.Devices.SDA.DiskID != 1 || opts.Devices.SDB.VolumeID != 2 || opts.Devices.SDC != nil {
+		t.Errorf("unexpected devices: %+v", opts.Devices)
+	}
+
+	if opts.Helpers == nil || !opts.Helpers.Network || len(opts.Interfaces) != 2 || opts.Interfaces[1].Label != "backend" {
+		t.Errorf("unexpected helpers or interfaces: %+v %+v", opts.Helpers, opts.Interfaces)
+	}
+}
+
+func TestInstanceConfig_GetCreateOptions_copies(t *testing.T) {
+	config := InstanceConfig{
+		Devices: &InstanceConfigDeviceMap{SDA: &InstanceConfigDevice{DiskID: 1}},
+		Helpers: &InstanceConfigHelpers{Network: true},
+		Interfaces: []InstanceConfigInterface{
+			{Purpose: InterfacePurposePublic},
+			{Purpose: InterfacePurposeVPC, SubnetID: Pointer(5), IPv4: &InstanceConfigInterfaceIPv4{VPC: "10.0.0.2"}},
+		},
+	}
+
+	opts := config.GetCreateOptions()
+	opts.Devices.SDA.DiskID = 2
+	opts.Helpers.Network = false
+	opts.Interfaces[0].Purpose = InterfacePurposeVLAN
+	*opts.Interfaces[1].SubnetID = 6
+	opts.Interfaces[1].IPv4.VPC = "10.0.0.3"
+
+	if config.Devices.SDA.DiskID != 1 || !config.Helpers.Network || config.Interfaces[0].Purpose != InterfacePurposePublic ||
+		*config.Interfaces[1].SubnetID != 5 || config.Interfaces[1].IPv4.VPC != "10.0.0.2" {
+		t.Errorf("expected the create options not to share state with the config, got %+v", config)
+	}
+
+	if opts := (InstanceConfig{}).GetCreateOptions(); opts.RootDevice != nil {
+		t.Errorf("expected no root device, got %q", *opts.RootDevice)
+	}
+}
+
+func TestInstanceConfig_GetCreateOptions_emptyInterfaces(t *testing.T) {
+	body, err := json.Marshal(InstanceConfig{Interfaces: []InstanceConfigInterface{}}.GetCreateOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(body), `"interfaces":[]`) {
+		t.Errorf("expected empty interfaces to be sent as an empty list, got %s", body)
+	}
+
+	if opts := (InstanceConfig{}).GetCreateOptions(); opts.Interfaces != nil {
+		t.Errorf("expected no interfaces, got %+v", opts.Interfaces)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/go-resty/resty/v2"
 	"github.com/linode/linodego/internal/parseabletime"
 )
 
@@ -72,30 +71,43 @@ type InstanceDiskUpdateOptions struct {
 	ReadOnly bool   `json:"read_only"`
 }
 
-// endpoint gets the endpoint URL for InstanceDisks of a given Instance
-func (InstanceDisksPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("linode/instances/%d/disks", id)
+// ListInstanceDisks lists InstanceDisks
+func (c *Client) ListInstanceDisks(ctx context.Context, linodeID int, opts *ListOptions) ([]InstanceDisk, error) {
+	e := fmt.Sprintf("linode/instances/%d/disks", linodeID)
+	return listPaginated[InstanceDisk](ctx, c, e, opts)
 }
 
-func (resp *InstanceDisksPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(InstanceDisksPagedResponse{}).Get(e))
+// GetInstanceDiskUsage returns the disk allowance of the Instance's plan in MB (total),
+// the space allocated to its disks (used), the space remaining for new disks (free),
+// and the Instance's disks.
+func (c *Client) GetInstanceDiskUsage(ctx context.Context, linodeID int) (total, used, free int, disks []InstanceDisk, err error) {
+	instance, err := c.GetInstance(ctx, linodeID)
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, 0, nil, err
 	}
-	castedRes := res.Result().(*InstanceDisksPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
 
-// ListInstanceDisks lists InstanceDisks
-func (c *Client) ListInstanceDisks(ctx context.Context, linodeID int, opts *ListOptions) ([]InstanceDisk, error) {
-	response := InstanceDisksPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, linodeID)
+	linodeType, err := c.GetType(ctx, instance.Type)
 	if err != nil {
-		return nil, err
+		return 0, 0, 0, nil, err
 	}
-	return response.Data, nil
+
+	disks, err = c.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+
+	for _, disk := range disks {
+		used += disk.Size
+	}
+
+	total = linodeType.Disk
+	free = total - used
+
+	if free < 0 {
+		free = 0
+	}
+
+	return total, used, free, disks, nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface
@@ -182,8 +194,7 @@ func (c *Client) ResizeInstanceDisk(ctx context.Context, linodeID int, diskID in
 	}
 
 	e := fmt.Sprintf("linode/instances/%d/disks/%d/resize", linodeID, diskID)
-	req := c.R(ctx).SetResult(&InstanceDisk{}).SetBody(body)
-	_, err = coupleAPIErrors(req.Post(e))
+	_, err = coupleAPIErrors(c.R(ctx).SetBody(string(body)).Post(e))
 	return err
 }
 
@@ -199,8 +210,7 @@ func (c *Client) PasswordResetInstanceDisk(ctx context.Context, linodeID int, di
 	}
 
 	e := fmt.Sprintf("linode/instances/%d/disks/%d/password", linodeID, diskID)
-	req := c.R(ctx).SetResult(&InstanceDisk{}).SetBody(string(body))
-	_, err = coupleAPIErrors(req.SetBody(body).Post(e))
+	_, err = coupleAPIErrors(c.R(ctx).SetBody(string(body)).Post(e))
 	return err
 }
 
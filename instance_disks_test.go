@@ -0,0 +1,25 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetInstanceDiskUsage(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances/123":       {http.StatusOK, `{"id": 123, "type": "g6-standard-1"}`},
+		"/v4/linode/types/g6-standard-1": {http.StatusOK, `{"id": "g6-standard-1", "disk": 51200}`},
+		"/v4/linode/instances/123/disks": {http.StatusOK, `{"data": [{"id": 1, "size": 25000}, {"id": 2, "size": 512}], "page": 1, "pages": 1, "results": 2}`},
+	})
+	defer ts.Close()
+
+	total, used, free, disks, err := client.GetInstanceDiskUsage(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if total != 51200 || used != 25512 || free != 25688 || len(disks) != 2 {
+		t.Errorf("unexpected disk usage: total=%d used=%d free=%d disks=%d", total, used, free, len(disks))
+	}
+}
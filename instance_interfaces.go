@@ -0,0 +1,490 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
+)
+
+// InterfaceGeneration is the networking model used by an Instance
+type InterfaceGeneration string
+
+// InterfaceGeneration constants start with InterfaceGeneration
+const (
+	// InterfaceGenerationLegacyConfig Instances configure their interfaces in their InstanceConfigs
+	InterfaceGenerationLegacyConfig InterfaceGeneration = "legacy_config"
+
+	// InterfaceGenerationLinode Instances have LinodeInterfaces, which are shared by all of their configs
+	InterfaceGenerationLinode InterfaceGeneration = "linode"
+)
+
+// LinodeInterface represents an interface of an Instance using the Linode Interfaces networking model.
+// Exactly one of Public, VPC and VLAN is set, depending on the kind of interface.
+type LinodeInterface struct {
+	ID           int                    `json:"id"`
+	Version      int                    `json:"version"`
+	MACAddress   string                 `json:"mac_address"`
+	DefaultRoute *InterfaceDefaultRoute `json:"default_route"`
+	Public       *PublicInterface       `json:"public"`
+	VPC          *VPCInterface          `json:"vpc"`
+	VLAN         *VLANInterface         `json:"vlan"`
+	Created      *time.Time             `json:"-"`
+	Updated      *time.Time             `json:"-"`
+}
+
+// InterfaceDefaultRoute reports whether an interface is the default route of its Instance
+// for IPv4 and IPv6 traffic
+type InterfaceDefaultRoute struct {
+	IPv4 *bool `json:"ipv4,omitempty"`
+	IPv6 *bool `json:"ipv6,omitempty"`
+}
+
+// PublicInterface contains the addresses of a public LinodeInterface
+type PublicInterface struct {
+	IPv4 *PublicInterfaceIPv4 `json:"ipv4"`
+	IPv6 *PublicInterfaceIPv6 `json:"ipv6"`
+}
+
+// PublicInterfaceIPv4 contains the IPv4 addresses of a public LinodeInterface
+type PublicInterfaceIPv4 struct {
+	Addresses []PublicInterfaceIPv4Address `json:"addresses"`
+	Shared    []PublicInterfaceIPv4Shared  `json:"shared"`
+}
+
+// PublicInterfaceIPv4Address is an IPv4 address assigned to a public LinodeInterface
+type PublicInterfaceIPv4Address struct {
+	Address string `json:"address"`
+	Primary bool   `json:"primary"`
+}
+
+// PublicInterfaceIPv4Shared is an IPv4 address shared with a public LinodeInterface by another Instance
+type PublicInterfaceIPv4Shared struct {
+	Address  string `json:"address"`
+	LinodeID int    `json:"linode_id"`
+}
+
+// PublicInterfaceIPv6 contains the IPv6 addresses and ranges of a public LinodeInterface
+type PublicInterfaceIPv6 struct {
+	Ranges []PublicInterfaceIPv6Range `json:"ranges"`
+	Shared []PublicInterfaceIPv6Range `json:"shared"`
+	SLAAC  []PublicInterfaceIPv6SLAAC `json:"slaac"`
+}
+
+// PublicInterfaceIPv6Range is an IPv6 range routed to a public LinodeInterface
+type PublicInterfaceIPv6Range struct {
+	Range       string  `json:"range"`
+	RouteTarget *string `json:"route_target"`
+}
+
+// PublicInterfaceIPv6SLAAC is the SLAAC address of a public LinodeInterface
+type PublicInterfaceIPv6SLAAC struct {
+	Address string `json:"address"`
+	Prefix  int    `json:"prefix"`
+}
+
+// VPCInterface contains the VPC subnet and addresses of a VPC LinodeInterface
+type VPCInterface struct {
+	VPCID    int              `json:"vpc_id"`
+	SubnetID int              `json:"subnet_id"`
+	IPv4     VPCInterfaceIPv4 `json:"ipv4"`
+}
+
+// VPCInterfaceIPv4 contains the IPv4 addresses and ranges of a VPC LinodeInterface
+type VPCInterfaceIPv4 struct {
+	Addresses []VPCInterfaceIPv4Address `json:"addresses"`
+	Ranges    []VPCInterfaceIPv4Range   `json:"ranges"`
+}
+
+// VPCInterfaceIPv4Address is an IPv4 address of a VPC LinodeInterface. NAT1To1Address is
+// the public IPv4 address the VPC address is translated to, if any.
+type VPCInterfaceIPv4Address struct {
+	Address        string  `json:"address"`
+	Primary        bool    `json:"primary"`
+	NAT1To1Address *string `json:"nat_1_1_address"`
+}
+
+// VPCInterfaceIPv4Range is an IPv4 range routed to a VPC LinodeInterface
+type VPCInterfaceIPv4Range struct {
+	Range string `json:"range"`
+}
+
+// VLANInterface contains the VLAN of a VLAN LinodeInterface
+type VLANInterface struct {
+	VLANLabel   string  `json:"vlan_label"`
+	IPAMAddress *string `json:"ipam_address,omitempty"`
+}
+
+// linodeInterfacesResponse is the response of ListLinodeInterfaces
+type linodeInterfacesResponse struct {
+	Interfaces []LinodeInterface `json:"interfaces"`
+}
+
+// LinodeInterfaceCreateOptions fields are those accepted by CreateLinodeInterface.
+// Exactly one of Public, VPC and VLAN must be set.
+type LinodeInterfaceCreateOptions struct {
+	// FirewallID is the Firewall assigned to a public or VPC interface
+	FirewallID   *int                    `json:"firewall_id,omitempty"`
+	DefaultRoute *InterfaceDefaultRoute  `json:"default_route,omitempty"`
+	Public       *PublicInterfaceOptions `json:"public,omitempty"`
+	VPC          *VPCInterfaceOptions    `json:"vpc,omitempty"`
+	VLAN         *VLANInterface          `json:"vlan,omitempty"`
+}
+
+// LinodeInterfaceUpdateOptions fields are those accepted by UpdateLinodeInterface.
+// The kind of an interface cannot be changed, and VLAN interfaces cannot be updated.
+type LinodeInterfaceUpdateOptions struct {
+	DefaultRoute *InterfaceDefaultRoute  `json:"default_route,omitempty"`
+	Public       *PublicInterfaceOptions `json:"public,omitempty"`
+	VPC          *VPCInterfaceOptions    `json:"vpc,omitempty"`
+}
+
+// PublicInterfaceOptions are the addresses of a public LinodeInterface to create or update
+type PublicInterfaceOptions struct {
+	IPv4 *PublicInterfaceIPv4Options `json:"ipv4,omitempty"`
+	IPv6 *PublicInterfaceIPv6Options `json:"ipv6,omitempty"`
+}
+
+// PublicInterfaceIPv4Options are the IPv4 addresses of a public LinodeInterface.
+// An Address of "auto" assigns a new address.
+type PublicInterfaceIPv4Options struct {
+	Addresses []PublicInterfaceIPv4AddressOptions `json:"addresses"`
+}
+
+// PublicInterfaceIPv4AddressOptions is an IPv4 address to assign to a public LinodeInterface
+type PublicInterfaceIPv4AddressOptions struct {
+	Address string `json:"address"`
+	Primary *bool  `json:"primary,omitempty"`
+}
+
+// PublicInterfaceIPv6Options are the IPv6 ranges of a public LinodeInterface
+type PublicInterfaceIPv6Options struct {
+	Ranges []PublicInterfaceIPv6RangeOptions `json:"ranges"`
+}
+
+// PublicInterfaceIPv6RangeOptions is an IPv6 range to route to a public LinodeInterface,
+// either an existing range or a prefix length such as "/64" to assign a new one
+type PublicInterfaceIPv6RangeOptions struct {
+	Range string `json:"range"`
+}
+
+// VPCInterfaceOptions are the VPC subnet and addresses of a VPC LinodeInterface to create or update.
+// SubnetID is only accepted on creation.
+type VPCInterfaceOptions struct {
+	SubnetID int                      `json:"subnet_id,omitempty"`
+	IPv4     *VPCInterfaceIPv4Options `json:"ipv4,omitempty"`
+}
+
+// VPCInterfaceIPv4Options are the IPv4 addresses and ranges of a VPC LinodeInterface.
+// An Address or NAT1To1Address of "auto" assigns a new address.
+type VPCInterfaceIPv4Options struct {
+	Addresses []VPCInterfaceIPv4AddressOptions `json:"addresses,omitempty"`
+	Ranges    []VPCInterfaceIPv4Range          `json:"ranges,omitempty"`
+}
+
+// VPCInterfaceIPv4AddressOptions is an IPv4 address to assign to a VPC LinodeInterface
+type VPCInterfaceIPv4AddressOptions struct {
+	Address        string  `json:"address"`
+	Primary        *bool   `json:"primary,omitempty"`
+	NAT1To1Address *string `json:"nat_1_1_address,omitempty"`
+}
+
+// LinodeInterfaceSettings are the networking settings shared by all of an Instance's LinodeInterfaces
+type LinodeInterfaceSettings struct {
+	NetworkHelper bool                         `json:"network_helper"`
+	DefaultRoute  LinodeInterfaceDefaultRoutes `json:"default_route"`
+}
+
+// LinodeInterfaceDefaultRoutes are the interfaces of an Instance that are the default route for
+// IPv4 and IPv6 traffic, and the interfaces that are eligible to be
+type LinodeInterfaceDefaultRoutes struct {
+	IPv4InterfaceID          *int  `json:"ipv4_interface_id"`
+	IPv4EligibleInterfaceIDs []int `json:"ipv4_eligible_interface_ids"`
+	IPv6InterfaceID          *int  `json:"ipv6_interface_id"`
+	IPv6EligibleInterfaceIDs []int `json:"ipv6_eligible_interface_ids"`
+}
+
+// LinodeInterfaceSettingsUpdateOptions fields are those accepted by UpdateLinodeInterfaceSettings
+type LinodeInterfaceSettingsUpdateOptions struct {
+	NetworkHelper *bool                                      `json:"network_helper,omitempty"`
+	DefaultRoute  *LinodeInterfaceDefaultRoutesUpdateOptions `json:"default_route,omitempty"`
+}
+
+// LinodeInterfaceDefaultRoutesUpdateOptions sets the interfaces of an Instance that are the default
+// route for IPv4 and IPv6 traffic
+type LinodeInterfaceDefaultRoutesUpdateOptions struct {
+	IPv4InterfaceID *int `json:"ipv4_interface_id,omitempty"`
+	IPv6InterfaceID *int `json:"ipv6_interface_id,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *LinodeInterface) UnmarshalJSON(b []byte) error {
+	type Mask LinodeInterface
+
+	p := struct {
+		*Mask
+		Created *parseabletime.ParseableTime `json:"created"`
+		Updated *parseabletime.ParseableTime `json:"updated"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Created = (*time.Time)(p.Created)
+	i.Updated = (*time.Time)(p.Updated)
+
+	return nil
+}
+
+// ListLinodeInterfaces lists the LinodeInterfaces of an Instance.
+// The API returns all of an Instance's interfaces at once, so the list is not paginated.
+func (c *Client) ListLinodeInterfaces(ctx context.Context, linodeID int) ([]LinodeInterface, error) {
+	e := fmt.Sprintf("linode/instances/%d/interfaces", linodeID)
+	req := c.R(ctx).SetResult(&linodeInterfacesResponse{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*linodeInterfacesResponse).Interfaces, nil
+}
+
+// GetLinodeInterface gets the LinodeInterface with the provided ID
+func (c *Client) GetLinodeInterface(ctx context.Context, linodeID int, interfaceID int) (*LinodeInterface, error) {
+	e := fmt.Sprintf("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	req := c.R(ctx).SetResult(&LinodeInterface{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterface), nil
+}
+
+// CreateLinodeInterface creates a LinodeInterface on an Instance that uses the Linode Interfaces networking model
+func (c *Client) CreateLinodeInterface(ctx context.Context, linodeID int, opts LinodeInterfaceCreateOptions) (*LinodeInterface, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/interfaces", linodeID)
+	req := c.R(ctx).SetResult(&LinodeInterface{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterface), nil
+}
+
+// UpdateLinodeInterface updates the LinodeInterface with the provided ID
+func (c *Client) UpdateLinodeInterface(ctx context.Context, linodeID int, interfaceID int, opts LinodeInterfaceUpdateOptions) (*LinodeInterface, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	req := c.R(ctx).SetResult(&LinodeInterface{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Put(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterface), nil
+}
+
+// DeleteLinodeInterface deletes the LinodeInterface with the provided ID
+func (c *Client) DeleteLinodeInterface(ctx context.Context, linodeID int, interfaceID int) error {
+	e := fmt.Sprintf("linode/instances/%d/interfaces/%d", linodeID, interfaceID)
+	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
+	return err
+}
+
+// GetLinodeInterfaceSettings gets the settings shared by all of an Instance's LinodeInterfaces
+func (c *Client) GetLinodeInterfaceSettings(ctx context.Context, linodeID int) (*LinodeInterfaceSettings, error) {
+	e := fmt.Sprintf("linode/instances/%d/interfaces/settings", linodeID)
+	req := c.R(ctx).SetResult(&LinodeInterfaceSettings{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterfaceSettings), nil
+}
+
+// UpdateLinodeInterfaceSettings updates the settings shared by all of an Instance's LinodeInterfaces
+func (c *Client) UpdateLinodeInterfaceSettings(ctx context.Context, linodeID int, opts LinodeInterfaceSettingsUpdateOptions) (*LinodeInterfaceSettings, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/interfaces/settings", linodeID)
+	req := c.R(ctx).SetResult(&LinodeInterfaceSettings{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Put(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*LinodeInterfaceSettings), nil
+}
+
+// ListLinodeInterfaceFirewalls lists the Firewalls assigned to a LinodeInterface
+func (c *Client) ListLinodeInterfaceFirewalls(ctx context.Context, linodeID int, interfaceID int, opts *ListOptions) ([]Firewall, error) {
+	e := fmt.Sprintf("linode/instances/%d/interfaces/%d/firewalls", linodeID, interfaceID)
+	return listPaginated[Firewall](ctx, c, e, opts)
+}
+
+// SetLinodeInterfaceFirewall makes firewallID the only Firewall assigned to a LinodeInterface,
+// removing the interface from any other Firewall it is assigned to. A firewallID of 0 removes
+// the interface from all Firewalls. Only public and VPC interfaces can be assigned a Firewall.
+func (c *Client) SetLinodeInterfaceFirewall(ctx context.Context, linodeID int, interfaceID int, firewallID int) error {
+	firewalls, err := c.ListLinodeInterfaceFirewalls(ctx, linodeID, interfaceID, nil)
+	if err != nil {
+		return err
+	}
+
+	assigned := false
+
+	for _, firewall := range firewalls {
+		if firewall.ID == firewallID {
+			assigned = true
+			continue
+		}
+
+		if err := c.removeLinodeInterfaceFromFirewall(ctx, firewall.ID, interfaceID); err != nil {
+			return err
+		}
+	}
+
+	if assigned || firewallID == 0 {
+		return nil
+	}
+
+	_, err = c.CreateFirewallDevice(ctx, firewallID, FirewallDeviceCreateOptions{
+		ID:   interfaceID,
+		Type: FirewallDeviceLinodeInterface,
+	})
+
+	return err
+}
+
+func (c *Client) removeLinodeInterfaceFromFirewall(ctx context.Context, firewallID int, interfaceID int) error {
+	devices, err := c.ListFirewallDevices(ctx, firewallID, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range devices {
+		if device.Entity.Type == FirewallDeviceLinodeInterface && device.Entity.ID == interfaceID {
+			return c.DeleteFirewallDevice(ctx, firewallID, device.ID)
+		}
+	}
+
+	return nil
+}
+
+// SetDefaultRouteInterface makes a LinodeInterface the default route of its Instance for IPv4
+// traffic if ipv4 is true and for IPv6 traffic if ipv6 is true. The default route of a family
+// that is false is left unchanged. Since an Instance has exactly one default route per family,
+// this replaces the previous default route interface of the chosen families. An error is returned
+// without updating the settings if the interface is not eligible to be the default route of a
+// chosen family; only public interfaces can be the IPv6 default route.
+func (c *Client) SetDefaultRouteInterface(ctx context.Context, linodeID int, interfaceID int, ipv4, ipv6 bool) (*LinodeInterfaceSettings, error) {
+	if !ipv4 && !ipv6 {
+		return nil, fmt.Errorf("at least one of ipv4 and ipv6 must be chosen to set the default route interface")
+	}
+
+	settings, err := c.GetLinodeInterfaceSettings(ctx, linodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := &LinodeInterfaceDefaultRoutesUpdateOptions{}
+
+	if ipv4 {
+		if !containsInt(settings.DefaultRoute.IPv4EligibleInterfaceIDs, interfaceID) {
+			return nil, fmt.Errorf("interface %d of instance %d is not eligible to be the IPv4 default route", interfaceID, linodeID)
+		}
+
+		routes.IPv4InterfaceID = &interfaceID
+	}
+
+	if ipv6 {
+		if !containsInt(settings.DefaultRoute.IPv6EligibleInterfaceIDs, interfaceID) {
+			return nil, fmt.Errorf("interface %d of instance %d is not eligible to be the IPv6 default route", interfaceID, linodeID)
+		}
+
+		routes.IPv6InterfaceID = &interfaceID
+	}
+
+	return c.UpdateLinodeInterfaceSettings(ctx, linodeID, LinodeInterfaceSettingsUpdateOptions{DefaultRoute: routes})
+}
+
+// ValidateLinodeInterfaceDefaultRoutes checks that the interfaces to create on an Instance have a
+// single default route per family: at most one interface may be the IPv4 default route and at most
+// one the IPv6 default route, and if more than one interface is eligible for a family, one of them
+// must be chosen. Public and VPC interfaces are eligible for IPv4, only public interfaces are
+// eligible for IPv6, and VLAN interfaces cannot be a default route.
+func ValidateLinodeInterfaceDefaultRoutes(interfaces []LinodeInterfaceCreateOptions) error {
+	var ipv4Eligible, ipv6Eligible, ipv4Routes, ipv6Routes int
+
+	for i, iface := range interfaces {
+		if iface.Public != nil {
+			ipv4Eligible++
+			ipv6Eligible++
+		} else if iface.VPC != nil {
+			ipv4Eligible++
+		}
+
+		if iface.DefaultRoute == nil {
+			continue
+		}
+
+		if iface.DefaultRoute.IPv4 != nil && *iface.DefaultRoute.IPv4 {
+			if iface.Public == nil && iface.VPC == nil {
+				return fmt.Errorf("interface %d cannot be the IPv4 default route, only public and VPC interfaces can", i)
+			}
+
+			ipv4Routes++
+		}
+
+		if iface.DefaultRoute.IPv6 != nil && *iface.DefaultRoute.IPv6 {
+			if iface.Public == nil {
+				return fmt.Errorf("interface %d cannot be the IPv6 default route, only public interfaces can", i)
+			}
+
+			ipv6Routes++
+		}
+	}
+
+	switch {
+	case ipv4Routes > 1:
+		return fmt.Errorf("%d interfaces are the IPv4 default route, only one can be", ipv4Routes)
+	case ipv6Routes > 1:
+		return fmt.Errorf("%d interfaces are the IPv6 default route, only one can be", ipv6Routes)
+	case ipv4Eligible > 1 && ipv4Routes == 0:
+		return fmt.Errorf("%d interfaces are eligible to be the IPv4 default route, one of them must be chosen", ipv4Eligible)
+	case ipv6Eligible > 1 && ipv6Routes == 0:
+		return fmt.Errorf("%d interfaces are eligible to be the IPv6 default route, one of them must be chosen", ipv6Eligible)
+	}
+
+	return nil
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
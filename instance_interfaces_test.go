@@ -0,0 +1,131 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_ListLinodeInterfaces(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123/interfaces", "application/json", `{"interfaces": [
+		{
+			"id": 1,
+			"mac_address": "22:00:AB:CD:EF:01",
+			"created": "2025-01-01T00:00:00",
+			"default_route": {"ipv4": true, "ipv6": true},
+			"public": {"ipv4": {"addresses": [{"address": "172.30.0.50", "primary": true}], "shared": []}},
+			"vpc": null,
+			"vlan": null
+		},
+		{
+			"id": 2,
+			"default_route": {"ipv4": false},
+			"public": null,
+			"vpc": {"vpc_id": 10, "subnet_id": 20, "ipv4": {"addresses": [{"address": "10.0.0.2", "primary": true, "nat_1_1_address": null}]}},
+			"vlan": null
+		},
+		{"id": 3, "public": null, "vpc": null, "vlan": {"vlan_label": "backend", "ipam_address": "192.168.0.1/24"}}
+	]}`, http.StatusOK)
+	defer ts.Close()
+
+	interfaces, err := client.ListLinodeInterfaces(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(interfaces) != 3 {
+		t.Fatalf("expected 3 interfaces, got %d", len(interfaces))
+	}
+
+	public := interfaces[0]
+	if public.Public == nil || public.Public.IPv4.Addresses[0].Address != "172.30.0.50" || public.Created == nil {
+		t.Errorf("unexpected public interface: %+v", public)
+	}
+
+	if public.DefaultRoute == nil || public.DefaultRoute.IPv4 == nil || !*public.DefaultRoute.IPv4 {
+		t.Errorf("expected the public interface to be the IPv4 default route, got %+v", public.DefaultRoute)
+	}
+
+	if vpc := interfaces[1].VPC; vpc == nil || vpc.SubnetID != 20 || vpc.IPv4.Addresses[0].Address != "10.0.0.2" {
+		t.Errorf("unexpected VPC interface: %+v", interfaces[1])
+	}
+
+	if vlan := interfaces[2].VLAN; vlan == nil || vlan.VLANLabel != "backend" || vlan.IPAMAddress == nil {
+		t.Errorf("unexpected VLAN interface: %+v", interfaces[2])
+	}
+}
+
+func TestClient_GetLinodeInterfaceSettings(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123/interfaces/settings", "application/json", `{
+		"network_helper": true,
+		"default_route": {"ipv4_interface_id": 1, "ipv4_eligible_interface_ids": [1, 2], "ipv6_interface_id": null, "ipv6_eligible_interface_ids": [1]}
+	}`, http.StatusOK)
+	defer ts.Close()
+
+	settings, err := client.GetLinodeInterfaceSettings(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes := settings.DefaultRoute
+	if !settings.NetworkHelper || routes.IPv4InterfaceID == nil || *routes.IPv4InterfaceID != 1 || routes.IPv6InterfaceID != nil {
+		t.Errorf("unexpected settings: %+v", settings)
+	}
+
+	if len(routes.IPv4EligibleInterfaceIDs) != 2 || len(routes.IPv6EligibleInterfaceIDs) != 1 {
+		t.Errorf("unexpected eligible interfaces: %+v", routes)
+	}
+}
+
+func TestClient_SetDefaultRouteInterface(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances/123/interfaces/settings": {http.StatusOK, `{
+			"network_helper": true,
+			"default_route": {"ipv4_interface_id": 1, "ipv4_eligible_interface_ids": [1, 2], "ipv6_interface_id": 1, "ipv6_eligible_interface_ids": [1]}
+		}`},
+	})
+	defer ts.Close()
+
+	if _, err := client.SetDefaultRouteInterface(context.Background(), 123, 2, true, false); err != nil {
+		t.Errorf("expected interface 2 to be eligible for IPv4, got %s", err)
+	}
+
+	if _, err := client.SetDefaultRouteInterface(context.Background(), 123, 2, true, true); err == nil {
+		t.Error("expected interface 2 to be ineligible for IPv6")
+	}
+
+	if _, err := client.SetDefaultRouteInterface(context.Background(), 123, 2, false, false); err == nil {
+		t.Error("expected an error when no family is chosen")
+	}
+}
+
+func TestValidateLinodeInterfaceDefaultRoutes(t *testing.T) {
+	public := &PublicInterfaceOptions{}
+	vpc := &VPCInterfaceOptions{SubnetID: 1}
+	vlan := &VLANInterface{VLANLabel: "backend"}
+	both := &InterfaceDefaultRoute{IPv4: Pointer(true), IPv6: Pointer(true)}
+
+	tests := []struct {
+		name       string
+		interfaces []LinodeInterfaceCreateOptions
+		valid      bool
+	}{
+		{"single public", []LinodeInterfaceCreateOptions{{Public: public}}, true},
+		{"public default with vpc and vlan", []LinodeInterfaceCreateOptions{{Public: public, DefaultRoute: both}, {VPC: vpc}, {VLAN: vlan}}, true},
+		{"vpc ipv4 default with public ipv6 default", []LinodeInterfaceCreateOptions{
+			{Public: public, DefaultRoute: &InterfaceDefaultRoute{IPv6: Pointer(true)}},
+			{VPC: vpc, DefaultRoute: &InterfaceDefaultRoute{IPv4: Pointer(true)}},
+		}, true},
+		{"no default chosen", []LinodeInterfaceCreateOptions{{Public: public}, {VPC: vpc}}, false},
+		{"two ipv4 defaults", []LinodeInterfaceCreateOptions{{Public: public, DefaultRoute: both}, {VPC: vpc, DefaultRoute: both}}, false},
+		{"vlan default", []LinodeInterfaceCreateOptions{{VLAN: vlan, DefaultRoute: &InterfaceDefaultRoute{IPv4: Pointer(true)}}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateLinodeInterfaceDefaultRoutes(test.interfaces); (err == nil) != test.valid {
+				t.Errorf("expected valid to be %t, got %v", test.valid, err)
+			}
+		})
+	}
+}
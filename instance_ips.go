@@ -31,6 +31,9 @@ type InstanceIP struct {
 	RDNS       string         `json:"rdns"`
 	LinodeID   int            `json:"linode_id"`
 	Region     string         `json:"region"`
+
+	// Reserved is true for reserved IPs, which remain on the account if the Instance is deleted
+	Reserved bool `json:"reserved"`
 }
 
 // InstanceIPv6Response contains the IPv6 addresses and ranges for an Instance
@@ -109,6 +112,31 @@ func (c *Client) AddInstanceIPAddress(ctx context.Context, linodeID int, public
 	return r.Result().(*InstanceIP), nil
 }
 
+// AssignReservedIPToInstance assigns a reserved IPv4 address to a Linode instance.
+// The address must be reserved in the same Region as the instance. To assign reserved
+// addresses when creating an instance, use InstanceCreateOptions.IPv4 instead.
+func (c *Client) AssignReservedIPToInstance(ctx context.Context, address string, linodeID int) (*InstanceIP, error) {
+	instanceipRequest := struct {
+		Type    string `json:"type"`
+		Public  bool   `json:"public"`
+		Address string `json:"address"`
+	}{"ipv4", true, address}
+
+	body, err := json.Marshal(instanceipRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	e := fmt.Sprintf("linode/instances/%d/ips", linodeID)
+	req := c.R(ctx).SetResult(&InstanceIP{}).SetBody(string(body))
+	r, err := coupleAPIErrors(req.Post(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*InstanceIP), nil
+}
+
 // UpdateInstanceIPAddress updates the IPAddress with the specified instance id and IP address
 func (c *Client) UpdateInstanceIPAddress(ctx context.Context, linodeID int, ipAddress string, opts IPAddressUpdateOptions) (*InstanceIP, error) {
 	body, err := json.Marshal(opts)
@@ -0,0 +1,66 @@
+package linodego
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetInstanceIPAddresses_reserved(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances/123/ips": {http.StatusOK, `{"ipv4": {
+			"public": [{"address": "192.0.2.1", "reserved": false}, {"address": "192.0.2.2", "reserved": true}],
+			"reserved": [{"address": "192.0.2.2", "reserved": true}]
+		}}`},
+	})
+	defer ts.Close()
+
+	ips, err := client.GetInstanceIPAddresses(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ips.IPv4.Public[0].Reserved || !ips.IPv4.Public[1].Reserved {
+		t.Errorf("unexpected reserved flags on public IPs: %+v, %+v", ips.IPv4.Public[0], ips.IPv4.Public[1])
+	}
+
+	if len(ips.IPv4.Reserved) != 1 || ips.IPv4.Reserved[0].Address != "192.0.2.2" {
+		t.Errorf("unexpected reserved IPs: %v", ips.IPv4.Reserved)
+	}
+}
+
+func TestClient_AssignReservedIPToInstance(t *testing.T) {
+	var body string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v4/linode/instances/123/ips" {
+			rw.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"address": "192.0.2.2", "linode_id": 123, "reserved": true}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	ip, err := client.AssignReservedIPToInstance(context.Background(), "192.0.2.2", 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"type":"ipv4","public":true,"address":"192.0.2.2"}`; body != expected {
+		t.Errorf("expected request body %s but got %s", expected, body)
+	}
+
+	if !ip.Reserved || ip.LinodeID != 123 {
+		t.Errorf("unexpected IP: %+v", ip)
+	}
+}
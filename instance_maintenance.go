@@ -0,0 +1,174 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	defaultInstanceShutdownTimeoutSeconds = 300
+	defaultInstanceBootTimeoutSeconds     = 300
+	defaultVolumeAttachTimeoutSeconds     = 180
+)
+
+// MaintenanceState records the state of an Instance before PrepareInstanceForMaintenance
+// shut it down and detached its Volumes, so that RestoreInstanceAfterMaintenance can reverse it.
+// It can be stored as JSON if the maintenance outlives the process.
+type MaintenanceState struct {
+	LinodeID int `json:"linode_id"`
+
+	// WasRunning is whether the Instance was running, in which case it is booted again on restore
+	WasRunning bool `json:"was_running"`
+
+	// BootConfigID is the config the Instance booted with, see GetInstanceBootConfig
+	BootConfigID int `json:"boot_config_id"`
+
+	// ConfigDevices are the devices of each of the Instance's configs that had a Volume attached, by config ID
+	ConfigDevices map[int]InstanceConfigDeviceMap `json:"config_devices"`
+
+	// VolumeIDs are the Volumes that were detached from the Instance
+	VolumeIDs []int `json:"volume_ids"`
+}
+
+// PrepareInstanceForMaintenance shuts an Instance down, waiting for it to be offline, and then detaches
+// its Volumes, waiting for each to be detached. The Volumes and their data are kept. The returned state
+// records what was changed so that RestoreInstanceAfterMaintenance can reverse it. If a step fails, the
+// state of the steps that succeeded is returned along with the error, so that they can be reversed.
+// An error is returned without making changes if the Instance is neither running nor offline.
+func (c *Client) PrepareInstanceForMaintenance(ctx context.Context, linodeID int) (MaintenanceState, error) {
+	state := MaintenanceState{
+		LinodeID:      linodeID,
+		ConfigDevices: make(map[int]InstanceConfigDeviceMap),
+	}
+
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return state, err
+	}
+
+	if instance.Status != InstanceRunning && instance.Status != InstanceOffline {
+		return state, fmt.Errorf("instance %d is %s, it must be running or offline to be prepared for maintenance", linodeID, instance.Status)
+	}
+
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return state, err
+	}
+
+	if len(configs) > 0 {
+		bootConfig, err := c.GetInstanceBootConfig(ctx, linodeID)
+		if err != nil {
+			return state, err
+		}
+
+		state.BootConfigID = bootConfig.ID
+	}
+
+	for _, config := range configs {
+		if config.Devices != nil && len(config.Devices.volumeIDs()) > 0 {
+			state.ConfigDevices[config.ID] = config.Devices.copy()
+		}
+	}
+
+	volumes, err := c.ListInstanceVolumes(ctx, linodeID, nil)
+	if err != nil {
+		return state, fmt.Errorf("failed to list volumes of instance %d: %w", linodeID, err)
+	}
+
+	if instance.Status == InstanceRunning {
+		if err := c.ShutdownInstance(ctx, linodeID); err != nil {
+			return state, fmt.Errorf("failed to shut down instance %d: %w", linodeID, err)
+		}
+
+		state.WasRunning = true
+
+		if _, err := c.WaitForInstanceStatus(ctx, linodeID, InstanceOffline, defaultInstanceShutdownTimeoutSeconds); err != nil {
+			return state, fmt.Errorf("failed to wait for instance %d to shut down: %w", linodeID, err)
+		}
+	}
+
+	for _, volume := range volumes {
+		if err := c.DetachVolume(ctx, volume.ID); err != nil {
+			return state, fmt.Errorf("failed to detach volume %d: %w", volume.ID, err)
+		}
+
+		state.VolumeIDs = append(state.VolumeIDs, volume.ID)
+
+		if _, err := c.WaitForVolumeLinodeID(ctx, volume.ID, nil, defaultVolumeDetachTimeoutSeconds); err != nil {
+			return state, fmt.Errorf("failed to wait for volume %d to detach: %w", volume.ID, err)
+		}
+	}
+
+	return state, nil
+}
+
+// RestoreInstanceAfterMaintenance reverses PrepareInstanceForMaintenance: it reattaches the Volumes that
+// were detached, waiting for each to be attached, restores the devices of the configs they were attached
+// to so that each Volume is at the same device as before, and boots the Instance with the config it last
+// booted with if it was running, waiting for it to be running.
+func (c *Client) RestoreInstanceAfterMaintenance(ctx context.Context, state MaintenanceState) error {
+	for _, volumeID := range state.VolumeIDs {
+		configID := state.BootConfigID
+
+		for id, devices := range state.ConfigDevices {
+			if containsInt(devices.volumeIDs(), volumeID) {
+				configID = id
+				break
+			}
+		}
+
+		if _, err := c.AttachVolume(ctx, volumeID, &VolumeAttachOptions{
+			LinodeID: state.LinodeID,
+			ConfigID: configID,
+		}); err != nil {
+			return fmt.Errorf("failed to attach volume %d: %w", volumeID, err)
+		}
+
+		linodeID := state.LinodeID
+		if _, err := c.WaitForVolumeLinodeID(ctx, volumeID, &linodeID, defaultVolumeAttachTimeoutSeconds); err != nil {
+			return fmt.Errorf("failed to wait for volume %d to attach: %w", volumeID, err)
+		}
+	}
+
+	for configID, devices := range state.ConfigDevices {
+		config, err := c.GetInstanceConfig(ctx, state.LinodeID, configID)
+		if err != nil {
+			return err
+		}
+
+		// The update options of the config are used so that its other settings are kept
+		updateOpts := config.GetUpdateOptions()
+		updateOpts.Devices = &devices
+
+		if _, err := c.UpdateInstanceConfig(ctx, state.LinodeID, configID, updateOpts); err != nil {
+			return fmt.Errorf("failed to restore the devices of config %d: %w", configID, err)
+		}
+	}
+
+	if !state.WasRunning {
+		return nil
+	}
+
+	if err := c.BootInstance(ctx, state.LinodeID, state.BootConfigID); err != nil {
+		return fmt.Errorf("failed to boot instance %d: %w", state.LinodeID, err)
+	}
+
+	if _, err := c.WaitForInstanceStatus(ctx, state.LinodeID, InstanceRunning, defaultInstanceBootTimeoutSeconds); err != nil {
+		return fmt.Errorf("failed to wait for instance %d to boot: %w", state.LinodeID, err)
+	}
+
+	return nil
+}
+
+// volumeIDs returns the IDs of the Volumes in the device map
+func (m InstanceConfigDeviceMap) volumeIDs() []int {
+	var ids []int
+
+	for _, device := range []*InstanceConfigDevice{m.SDA, m.SDB, m.SDC, m.SDD, m.SDE, m.SDF, m.SDG, m.SDH} {
+		if device != nil && device.VolumeID != 0 {
+			ids = append(ids, device.VolumeID)
+		}
+	}
+
+	return ids
+}
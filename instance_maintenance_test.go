@@ -0,0 +1,147 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestClient_PrepareAndRestoreInstanceMaintenance(t *testing.T) {
+	var (
+		lock         sync.Mutex
+		status       = InstanceRunning
+		volumeLinode = "123"
+		attachBody   map[string]any
+		updateBody   InstanceConfigUpdateOptions
+		bootBody     map[string]any
+		calls        []string
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		rw.Header().Add("Content-Type", "application/json")
+
+		route := r.Method + " " + r.URL.Path
+		if r.Method != http.MethodGet {
+			calls = append(calls, route)
+		}
+
+		switch route {
+		case "GET /v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "status": "` + string(status) + `"}`))
+		case "GET /v4/linode/instances/123/configs":
+			rw.Write([]byte(`{"data": [{"id": 1, "label": "Boot", "comments": "kept", "devices": {"sda": {"disk_id": 10}, "sdb": {"volume_id": 5}}}, {"id": 2, "label": "Rescue"}], "page": 1, "pages": 1, "results": 2}`))
+		case "GET /v4/linode/instances/123/configs/1":
+			rw.Write([]byte(`{"id": 1, "label": "Boot", "comments": "kept", "devices": {"sda": {"disk_id": 10}, "sdc": {"volume_id": 5}}}`))
+		case "GET /v4/account/events":
+			rw.Write([]byte(`{"data": [{"id": 10, "action": "linode_boot", "status": "finished", "entity": {"id": 123, "type": "linode"}, "secondary_entity": {"id": 1, "type": "linode_config"}}], "page": 1, "pages": 1, "results": 1}`))
+		case "GET /v4/linode/instances/123/volumes":
+			rw.Write([]byte(`{"data": [{"id": 5, "linode_id": 123}], "page": 1, "pages": 1, "results": 1}`))
+		case "POST /v4/linode/instances/123/shutdown":
+			status = InstanceOffline
+			rw.Write([]byte(`{}`))
+		case "GET /v4/volumes/5":
+			rw.Write([]byte(`{"id": 5, "linode_id": ` + volumeLinode + `}`))
+		case "POST /v4/volumes/5/detach":
+			volumeLinode = "null"
+			rw.Write([]byte(`{}`))
+		case "POST /v4/volumes/5/attach":
+			json.NewDecoder(r.Body).Decode(&attachBody)
+			volumeLinode = "123"
+			rw.Write([]byte(`{"id": 5, "linode_id": 123}`))
+		case "PUT /v4/linode/instances/123/configs/1":
+			json.NewDecoder(r.Body).Decode(&updateBody)
+			rw.Write([]byte(`{"id": 1}`))
+		case "POST /v4/linode/instances/123/boot":
+			json.NewDecoder(r.Body).Decode(&bootBody)
+			status = InstanceRunning
+			rw.Write([]byte(`{}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	state, err := client.PrepareInstanceForMaintenance(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !state.WasRunning || state.BootConfigID != 1 || len(state.VolumeIDs) != 1 || state.VolumeIDs[0] != 5 {
+		t.Errorf("unexpected maintenance state %+v", state)
+	}
+
+	if _, ok := state.ConfigDevices[2]; ok || state.ConfigDevices[1].SDB == nil || state.ConfigDevices[1].SDB.VolumeID != 5 {
+		t.Errorf("expected only the devices of the config with the volume to be captured, got %+v", state.ConfigDevices)
+	}
+
+	if status != InstanceOffline || volumeLinode != "null" {
+		t.Errorf("expected the instance to be offline and the volume detached, got %s and %s", status, volumeLinode)
+	}
+
+	// The state must survive being stored between the two calls
+	stored, err := json.Marshal(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var restored MaintenanceState
+	if err := json.Unmarshal(stored, &restored); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.RestoreInstanceAfterMaintenance(context.Background(), restored); err != nil {
+		t.Fatal(err)
+	}
+
+	if attachBody["linode_id"] != float64(123) || attachBody["config_id"] != float64(1) {
+		t.Errorf("expected the volume to be attached to its config, got %v", attachBody)
+	}
+
+	if updateBody.Devices == nil || updateBody.Devices.SDB == nil || updateBody.Devices.SDB.VolumeID != 5 || updateBody.Devices.SDC != nil || updateBody.Comments != "kept" {
+		t.Errorf("expected the volume to be restored to its device and the config kept, got %+v", updateBody)
+	}
+
+	if bootBody["config_id"] != float64(1) || status != InstanceRunning {
+		t.Errorf("expected the instance to be booted with its boot config, got %v", bootBody)
+	}
+
+	expected := []string{
+		"POST /v4/linode/instances/123/shutdown",
+		"POST /v4/volumes/5/detach",
+		"POST /v4/volumes/5/attach",
+		"PUT /v4/linode/instances/123/configs/1",
+		"POST /v4/linode/instances/123/boot",
+	}
+
+	if len(calls) != len(expected) {
+		t.Fatalf("expected the calls %v, got %v", expected, calls)
+	}
+
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("expected the calls %v, got %v", expected, calls)
+			break
+		}
+	}
+}
+
+func TestClient_PrepareInstanceForMaintenance_busy(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances/123": {status: http.StatusOK, body: `{"id": 123, "status": "migrating"}`},
+	})
+	defer ts.Close()
+
+	if _, err := client.PrepareInstanceForMaintenance(context.Background(), 123); err == nil {
+		t.Fatal("expected an error for an instance that is neither running nor offline")
+	}
+}
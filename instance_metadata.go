@@ -0,0 +1,59 @@
+package linodego
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// UserDataMaxSize is the maximum size in bytes of base64-encoded user-data accepted by the API
+const UserDataMaxSize = 65535
+
+// ErrUserDataTooLarge is returned when encoded user-data exceeds UserDataMaxSize
+var ErrUserDataTooLarge = errors.New("user-data exceeds the size limit")
+
+// EncodeUserData base64-encodes the given user-data for use in InstanceMetadataOptions.
+// An error wrapping ErrUserDataTooLarge is returned if the encoded user-data is too large.
+func EncodeUserData(data []byte) (string, error) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	if err := checkUserDataSize(encoded); err != nil {
+		return "", err
+	}
+
+	return encoded, nil
+}
+
+// DecodeUserData decodes base64-encoded user-data.
+// An error wrapping ErrUserDataTooLarge is returned if the encoded user-data is too large.
+func DecodeUserData(encoded string) ([]byte, error) {
+	if err := checkUserDataSize(encoded); err != nil {
+		return nil, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("user-data must be base64-encoded: %w", err)
+	}
+
+	return data, nil
+}
+
+func checkUserDataSize(encoded string) error {
+	if len(encoded) > UserDataMaxSize {
+		return fmt.Errorf("%w: %d bytes encoded, limit is %d bytes", ErrUserDataTooLarge, len(encoded), UserDataMaxSize)
+	}
+
+	return nil
+}
+
+// GetInstanceSSHHostKeys always returns ErrNotSupported, as the Linode API does not expose
+// the SSH host keys or fingerprints of an Instance.
+//
+// To verify an Instance's host key out-of-band, generate the host keys before provisioning
+// and supply them through cloud-init user-data (the "ssh_keys" module) using
+// InstanceCreateOptions.Metadata. The known public keys can then be trusted directly.
+func (c *Client) GetInstanceSSHHostKeys(_ context.Context, linodeID int) ([]string, error) {
+	return nil, fmt.Errorf("failed to get SSH host keys for instance %d: %w", linodeID, ErrNotSupported)
+}
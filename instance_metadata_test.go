@@ -0,0 +1,38 @@
+package linodego
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestEncodeUserData(t *testing.T) {
+	data := []byte("#cloud-config\npackages:\n  - nginx\n")
+
+	encoded, err := EncodeUserData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := DecodeUserData(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, decoded) {
+		t.Errorf("expected %q but got %q", data, decoded)
+	}
+}
+
+func TestEncodeUserData_tooLarge(t *testing.T) {
+	_, err := EncodeUserData(make([]byte, UserDataMaxSize))
+	if !errors.Is(err, ErrUserDataTooLarge) {
+		t.Fatalf("expected ErrUserDataTooLarge but got %v", err)
+	}
+}
+
+func TestDecodeUserData_invalid(t *testing.T) {
+	if _, err := DecodeUserData("not base64!"); err == nil {
+		t.Fatal("expected an error for invalid base64")
+	}
+}
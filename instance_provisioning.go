@@ -0,0 +1,342 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+const defaultVolumeDetachTimeoutSeconds = 180
+
+// InstanceDeprovisionOptions configures the dependencies removed by DeprovisionInstance
+type InstanceDeprovisionOptions struct {
+	// DetachVolumes detaches the Volumes attached to the Instance and waits for them
+	// to be detached before the Instance is deleted. The Volumes are not deleted.
+	DetachVolumes bool
+
+	// RemoveNodeBalancerNodes removes the Nodes addressing the Instance's private IPv4
+	// addresses from the configs of NodeBalancers in the Instance's region.
+	RemoveNodeBalancerNodes bool
+
+	// VolumeDetachTimeoutSeconds is how long to wait for each Volume to be detached, defaulting to 180
+	VolumeDetachTimeoutSeconds int
+}
+
+// InstanceDeprovisionResult reports the dependencies removed by DeprovisionInstance
+type InstanceDeprovisionResult struct {
+	DetachedVolumes          []Volume
+	RemovedNodeBalancerNodes []NodeBalancerNode
+}
+
+// ProvisionInstance creates an Instance, waits for it to finish provisioning and returns it
+// with its IPv4 addresses populated from the Instance's IP addresses. The Instance is expected
+// to reach the running status, or offline if it is not booted after creation (Booted is false
+// or no Image is given). It will timeout with an error after timeoutSeconds.
+// If the Instance was created but could not be waited for, it is returned along with the error
+// so it can be cleaned up.
+func (c *Client) ProvisionInstance(ctx context.Context, opts InstanceCreateOptions, timeoutSeconds int) (*Instance, error) {
+	status := InstanceRunning
+	if opts.Image == "" || (opts.Booted != nil && !*opts.Booted) {
+		status = InstanceOffline
+	}
+
+	instance, err := c.CreateInstance(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	provisioned, err := c.WaitForInstanceStatus(ctx, instance.ID, status, timeoutSeconds)
+	if err != nil {
+		if provisioned != nil {
+			instance = provisioned
+		}
+
+		return instance, fmt.Errorf("failed to provision instance %d: %w", instance.ID, err)
+	}
+
+	ips, err := c.GetInstanceIPAddresses(ctx, provisioned.ID)
+	if err != nil {
+		return provisioned, fmt.Errorf("failed to get IP addresses of instance %d: %w", provisioned.ID, err)
+	}
+
+	if ips.IPv4 != nil {
+		addresses := make([]*net.IP, 0, len(ips.IPv4.Public)+len(ips.IPv4.Private))
+
+		for _, ip := range append(ips.IPv4.Public, ips.IPv4.Private...) {
+			if parsed := net.ParseIP(ip.Address); parsed != nil {
+				addresses = append(addresses, &parsed)
+			}
+		}
+
+		provisioned.IPv4 = addresses
+	}
+
+	if provisioned.IPv6 == "" && ips.IPv6 != nil && ips.IPv6.SLAAC != nil {
+		provisioned.IPv6 = fmt.Sprintf("%s/%d", ips.IPv6.SLAAC.Address, ips.IPv6.SLAAC.Prefix)
+	}
+
+	return provisioned, nil
+}
+
+// DeprovisionInstance removes the dependencies of an Instance selected by opts and then deletes it.
+// The dependencies that were removed are reported even if a later step fails.
+func (c *Client) DeprovisionInstance(ctx context.Context, linodeID int, opts InstanceDeprovisionOptions) (*InstanceDeprovisionResult, error) {
+	result := &InstanceDeprovisionResult{}
+
+	if opts.RemoveNodeBalancerNodes {
+		if err := c.removeInstanceNodeBalancerNodes(ctx, linodeID, result); err != nil {
+			return result, err
+		}
+	}
+
+	if opts.DetachVolumes {
+		if opts.VolumeDetachTimeoutSeconds == 0 {
+			opts.VolumeDetachTimeoutSeconds = defaultVolumeDetachTimeoutSeconds
+		}
+
+		if err := c.detachInstanceVolumes(ctx, linodeID, opts.VolumeDetachTimeoutSeconds, result); err != nil {
+			return result, err
+		}
+	}
+
+	if err := c.DeleteInstance(ctx, linodeID); err != nil {
+		return result, fmt.Errorf("failed to delete instance %d: %w", linodeID, err)
+	}
+
+	return result, nil
+}
+
+func (c *Client) detachInstanceVolumes(ctx context.Context, linodeID, timeoutSeconds int, result *InstanceDeprovisionResult) error {
+	volumes, err := c.ListInstanceVolumes(ctx, linodeID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list volumes of instance %d: %w", linodeID, err)
+	}
+
+	for _, volume := range volumes {
+		if err := c.DetachVolume(ctx, volume.ID); err != nil {
+			return fmt.Errorf("failed to detach volume %d: %w", volume.ID, err)
+		}
+
+		if _, err := c.WaitForVolumeLinodeID(ctx, volume.ID, nil, timeoutSeconds); err != nil {
+			return fmt.Errorf("failed to wait for volume %d to detach: %w", volume.ID, err)
+		}
+
+		result.DetachedVolumes = append(result.DetachedVolumes, volume)
+	}
+
+	return nil
+}
+
+func (c *Client) removeInstanceNodeBalancerNodes(ctx context.Context, linodeID int, result *InstanceDeprovisionResult) error {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return err
+	}
+
+	ips, err := c.GetInstanceIPAddresses(ctx, linodeID)
+	if err != nil {
+		return fmt.Errorf("failed to get IP addresses of instance %d: %w", linodeID, err)
+	}
+
+	if ips.IPv4 == nil || len(ips.IPv4.Private) == 0 {
+		return nil
+	}
+
+	addresses := make(map[string]bool, len(ips.IPv4.Private))
+	for _, ip := range ips.IPv4.Private {
+		addresses[ip.Address] = true
+	}
+
+	f := Filter{}
+	f.AddField(Eq, "region", instance.Region)
+
+	filter, err := f.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	nodeBalancers, err := c.ListNodeBalancers(ctx, NewListOptions(0, string(filter)))
+	if err != nil {
+		return fmt.Errorf("failed to list nodebalancers: %w", err)
+	}
+
+	for _, nodeBalancer := range nodeBalancers {
+		configs, err := c.ListNodeBalancerConfigs(ctx, nodeBalancer.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list configs of nodebalancer %d: %w", nodeBalancer.ID, err)
+		}
+
+		for _, config := range configs {
+			nodes, err := c.ListNodeBalancerNodes(ctx, nodeBalancer.ID, config.ID, nil)
+			if err != nil {
+				return fmt.Errorf("failed to list nodes of nodebalancer %d config %d: %w", nodeBalancer.ID, config.ID, err)
+			}
+
+			for _, node := range nodes {
+				host, _, err := net.SplitHostPort(node.Address)
+				if err != nil || !addresses[host] {
+					continue
+				}
+
+				if err := c.DeleteNodeBalancerNode(ctx, nodeBalancer.ID, config.ID, node.ID); err != nil {
+					return fmt.Errorf("failed to remove node %d of nodebalancer %d: %w", node.ID, nodeBalancer.ID, err)
+				}
+
+				result.RemovedNodeBalancerNodes = append(result.RemovedNodeBalancerNodes, node)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RebuildInstancePreservingInterfaces rebuilds an Instance like RebuildInstance, but keeps the
+// network interfaces of the config it boots with (see GetInstanceBootConfig), including VLAN and
+// VPC attachments and their addresses, rather than resetting them to a single public interface. Since the API deletes the Instance's configs when rebuilding,
+// the interfaces are applied to the config created by the rebuild once it has finished, before the
+// Instance is booted with it unless opts.Booted is false. It will timeout with an error after
+// timeoutSeconds, which is shared by the rebuild and boot.
+func (c *Client) RebuildInstancePreservingInterfaces(ctx context.Context, linodeID int, opts InstanceRebuildOptions, timeoutSeconds int) (*Instance, error) {
+	ctx, cancel := c.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	bootConfig, err := c.GetInstanceBootConfig(ctx, linodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the interfaces of instance %d: %w", linodeID, err)
+	}
+
+	boot := opts.Booted == nil || *opts.Booted
+	opts.Booted = Pointer(false)
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeRebuild)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := c.RebuildInstance(ctx, linodeID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return instance, fmt.Errorf("failed to wait for instance %d to be rebuilt: %w", linodeID, err)
+	}
+
+	configs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return instance, err
+	}
+
+	if len(configs) == 0 {
+		return instance, fmt.Errorf("instance %d has no configs after being rebuilt", linodeID)
+	}
+
+	// The update options of the config are used so that its other settings are kept
+	updateOpts := configs[0].GetUpdateOptions()
+	updateOpts.Interfaces = bootConfig.Interfaces
+
+	if _, err := c.UpdateInstanceConfig(ctx, linodeID, configs[0].ID, updateOpts); err != nil {
+		return instance, fmt.Errorf("failed to restore the interfaces of instance %d: %w", linodeID, err)
+	}
+
+	if !boot {
+		return c.GetInstance(ctx, linodeID)
+	}
+
+	if err := c.BootInstance(ctx, linodeID, configs[0].ID); err != nil {
+		return instance, err
+	}
+
+	booted, err := c.WaitForInstanceStatus(ctx, linodeID, InstanceRunning, timeoutSeconds)
+	if err != nil && booted == nil {
+		booted = instance
+	}
+
+	return booted, err
+}
+
+// CloneInstancePreservingInterfaces clones an Instance like CloneInstance and waits for the clone
+// to finish, then gives each of the cloned configs the network interfaces of the config it was
+// cloned from, so that the VLAN and VPC attachments of the source are kept. The configs are matched
+// by label. VLAN IPAM addresses are copied as they are, so they must be changed before both Instances
+// are attached to the same VLAN at once. VPC interfaces keep their subnet, but their VPC and 1:1 NAT
+// addresses and IP ranges belong to the source, so new addresses are assigned by the API and no
+// ranges are routed to the clone. It will timeout with an error after timeoutSeconds.
+func (c *Client) CloneInstancePreservingInterfaces(ctx context.Context, linodeID int, opts InstanceCloneOptions, timeoutSeconds int) (*Instance, error) {
+	ctx, cancel := c.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	sourceConfigs, err := c.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the interfaces of instance %d: %w", linodeID, err)
+	}
+
+	interfaces := make(map[string][]InstanceConfigInterface, len(sourceConfigs))
+	for _, config := range sourceConfigs {
+		interfaces[config.Label] = config.Interfaces
+	}
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeClone)
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := c.CloneInstance(ctx, linodeID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return instance, fmt.Errorf("failed to wait for instance %d to be cloned: %w", linodeID, err)
+	}
+
+	configs, err := c.ListInstanceConfigs(ctx, instance.ID, nil)
+	if err != nil {
+		return instance, err
+	}
+
+	for _, config := range configs {
+		source, ok := interfaces[config.Label]
+		if !ok || len(source) == 0 {
+			continue
+		}
+
+		updateOpts := config.GetUpdateOptions()
+		updateOpts.Interfaces = cloneConfigInterfaces(source)
+
+		if _, err := c.UpdateInstanceConfig(ctx, instance.ID, config.ID, updateOpts); err != nil {
+			return instance, fmt.Errorf("failed to restore the interfaces of config %d of instance %d: %w", config.ID, instance.ID, err)
+		}
+	}
+
+	return c.GetInstance(ctx, instance.ID)
+}
+
+// cloneConfigInterfaces returns copies of the interfaces of a config for the config of a cloned
+// Instance, leaving the VPC and 1:1 NAT addresses and the IP ranges of the source's VPC interfaces
+// to be assigned by the API.
+func cloneConfigInterfaces(interfaces []InstanceConfigInterface) []InstanceConfigInterface {
+	result := make([]InstanceConfigInterface, len(interfaces))
+
+	for i, iface := range interfaces {
+		result[i] = iface.copy()
+
+		if iface.Purpose != InterfacePurposeVPC {
+			continue
+		}
+
+		result[i].IPRanges = nil
+
+		if iface.IPv4 != nil {
+			ipv4 := InstanceConfigInterfaceIPv4{}
+			if iface.IPv4.NAT1To1 != nil {
+				ipv4.NAT1To1 = Pointer("any")
+			}
+
+			result[i].IPv4 = &ipv4
+		}
+	}
+
+	return result
+}
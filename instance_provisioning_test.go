@@ -0,0 +1,287 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_ProvisionInstance(t *testing.T) {
+	polls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/linode/instances":
+			rw.Write([]byte(`{"id": 123, "status": "provisioning"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/linode/instances/123":
+			polls++
+			if polls < 3 {
+				rw.Write([]byte(`{"id": 123, "status": "provisioning"}`))
+				return
+			}
+
+			rw.Write([]byte(`{"id": 123, "status": "running", "ipv4": ["192.0.2.1"]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/linode/instances/123/ips":
+			rw.Write([]byte(`{
+				"ipv4": {"public": [{"address": "192.0.2.1"}], "private": [{"address": "192.168.128.1"}]},
+				"ipv6": {"slaac": {"address": "2001:db8::1", "prefix": 128}}
+			}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	instance, err := client.ProvisionInstance(context.Background(), InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Image:  "linode/debian11",
+	}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != InstanceRunning {
+		t.Errorf("expected instance to be running, got %s", instance.Status)
+	}
+
+	if len(instance.IPv4) != 2 || instance.IPv4[1].String() != "192.168.128.1" {
+		t.Errorf("unexpected IPv4 addresses: %v", instance.IPv4)
+	}
+
+	if instance.IPv6 != "2001:db8::1/128" {
+		t.Errorf("unexpected IPv6 address: %s", instance.IPv6)
+	}
+}
+
+func TestClient_DeprovisionInstance(t *testing.T) {
+	var requests []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		if r.Method != http.MethodGet {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+		}
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "region": "us-east"}`))
+		case "/v4/linode/instances/123/ips":
+			rw.Write([]byte(`{"ipv4": {"private": [{"address": "192.168.128.1"}]}}`))
+		case "/v4/nodebalancers":
+			rw.Write([]byte(`{"data": [{"id": 1}], "page": 1, "pages": 1, "results": 1}`))
+		case "/v4/nodebalancers/1/configs":
+			rw.Write([]byte(`{"data": [{"id": 2}], "page": 1, "pages": 1, "results": 1}`))
+		case "/v4/nodebalancers/1/configs/2/nodes":
+			rw.Write([]byte(`{"data": [
+				{"id": 3, "address": "192.168.128.1:80"},
+				{"id": 4, "address": "192.168.128.2:80"}
+			], "page": 1, "pages": 1, "results": 2}`))
+		case "/v4/linode/instances/123/volumes":
+			rw.Write([]byte(`{"data": [{"id": 10, "linode_id": 123}], "page": 1, "pages": 1, "results": 1}`))
+		case "/v4/volumes/10":
+			rw.Write([]byte(`{"id": 10, "linode_id": null}`))
+		default:
+			rw.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	result, err := client.DeprovisionInstance(context.Background(), 123, InstanceDeprovisionOptions{
+		DetachVolumes:           true,
+		RemoveNodeBalancerNodes: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"DELETE /v4/nodebalancers/1/configs/2/nodes/3",
+		"POST /v4/volumes/10/detach",
+		"DELETE /v4/linode/instances/123",
+	}
+
+	if diff := cmp.Diff(expected, requests); diff != "" {
+		t.Errorf("unexpected requests: %s", diff)
+	}
+
+	if len(result.DetachedVolumes) != 1 || len(result.RemovedNodeBalancerNodes) != 1 || result.RemovedNodeBalancerNodes[0].ID != 3 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestClient_RebuildInstancePreservingInterfaces(t *testing.T) {
+	var (
+		lock        sync.Mutex
+		rebuilt     bool
+		rebuildBody map[string]any
+		updateBody  InstanceConfigUpdateOptions
+		bootBody    map[string]any
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v4/linode/instances/123/configs":
+			if rebuilt {
+				rw.Write([]byte(`{"data": [{"id": 2, "label": "Rebuilt", "comments": "kept", "interfaces": [{"purpose": "public"}]}], "page": 1, "pages": 1, "results": 1}`))
+				return
+			}
+
+			rw.Write([]byte(`{"data": [{"id": 1, "label": "Boot", "interfaces": [{"purpose": "public"}, {"purpose": "vlan", "label": "backend", "ipam_address": "10.0.0.1/24"}, {"purpose": "vpc", "subnet_id": 5, "ipv4": {"vpc": "10.1.0.2", "nat_1_1": "203.0.113.2"}, "ip_ranges": ["10.1.0.16/28"]}]}], "page": 1, "pages": 1, "results": 1}`))
+		case "GET /v4/account/events":
+			if rebuilt {
+				rw.Write([]byte(`{"data": [{"id": 10, "action": "linode_rebuild", "status": "started", "entity": {"id": 123, "type": "linode"}}], "page": 1, "pages": 1, "results": 1}`))
+				return
+			}
+
+			rw.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+		case "GET /v4/account/events/10":
+			rw.Write([]byte(`{"id": 10, "action": "linode_rebuild", "status": "finished"}`))
+		case "POST /v4/linode/instances/123/rebuild":
+			json.NewDecoder(r.Body).Decode(&rebuildBody)
+			rebuilt = true
+			rw.Write([]byte(`{"id": 123, "status": "rebuilding"}`))
+		case "PUT /v4/linode/instances/123/configs/2":
+			json.NewDecoder(r.Body).Decode(&updateBody)
+			rw.Write([]byte(`{"id": 2}`))
+		case "POST /v4/linode/instances/123/boot":
+			json.NewDecoder(r.Body).Decode(&bootBody)
+			rw.Write([]byte(`{}`))
+		case "GET /v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "status": "running"}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	instance, err := client.RebuildInstancePreservingInterfaces(context.Background(), 123, InstanceRebuildOptions{Image: "linode/debian12"}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != InstanceRunning {
+		t.Errorf("expected the instance to be booted, got %s", instance.Status)
+	}
+
+	if rebuildBody["booted"] != false {
+		t.Errorf("expected the rebuild not to boot the instance, got %v", rebuildBody)
+	}
+
+	if len(updateBody.Interfaces) != 3 || updateBody.Interfaces[1].Label != "backend" || updateBody.Comments != "kept" {
+		t.Fatalf("expected the VLAN and VPC interfaces to be restored and the config kept, got %+v", updateBody)
+	}
+
+	expectedVPC := InstanceConfigInterface{
+		Purpose:  InterfacePurposeVPC,
+		SubnetID: Pointer(5),
+		IPv4:     &InstanceConfigInterfaceIPv4{VPC: "10.1.0.2", NAT1To1: Pointer("203.0.113.2")},
+		IPRanges: []string{"10.1.0.16/28"},
+	}
+
+	if diff := cmp.Diff(expectedVPC, updateBody.Interfaces[2]); diff != "" {
+		t.Errorf("expected the VPC interface to be restored with its addresses: %s", diff)
+	}
+
+	if bootBody["config_id"] != float64(2) {
+		t.Errorf("expected the instance to be booted with the rebuilt config, got %v", bootBody)
+	}
+}
+
+func TestClient_CloneInstancePreservingInterfaces(t *testing.T) {
+	var (
+		lock       sync.Mutex
+		cloned     bool
+		updateBody InstanceConfigUpdateOptions
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v4/linode/instances/123/configs":
+			rw.Write([]byte(`{"data": [{"id": 1, "label": "Boot", "interfaces": [{"purpose": "vlan", "label": "backend", "ipam_address": "10.0.0.1/24"}, {"purpose": "vpc", "primary": true, "subnet_id": 5, "ipv4": {"vpc": "10.1.0.2", "nat_1_1": "203.0.113.2"}, "ip_ranges": ["10.1.0.16/28"]}]}], "page": 1, "pages": 1, "results": 1}`))
+		case "GET /v4/linode/instances/456/configs":
+			rw.Write([]byte(`{"data": [{"id": 2, "label": "Boot", "comments": "kept", "interfaces": [{"purpose": "public"}]}], "page": 1, "pages": 1, "results": 1}`))
+		case "GET /v4/account/events":
+			if cloned {
+				rw.Write([]byte(`{"data": [{"id": 10, "action": "linode_clone", "status": "started", "entity": {"id": 123, "type": "linode"}}], "page": 1, "pages": 1, "results": 1}`))
+				return
+			}
+
+			rw.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+		case "GET /v4/account/events/10":
+			rw.Write([]byte(`{"id": 10, "action": "linode_clone", "status": "finished"}`))
+		case "POST /v4/linode/instances/123/clone":
+			cloned = true
+			rw.Write([]byte(`{"id": 456, "status": "provisioning"}`))
+		case "PUT /v4/linode/instances/456/configs/2":
+			json.NewDecoder(r.Body).Decode(&updateBody)
+			rw.Write([]byte(`{"id": 2}`))
+		case "GET /v4/linode/instances/456":
+			rw.Write([]byte(`{"id": 456, "status": "offline"}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	instance, err := client.CloneInstancePreservingInterfaces(context.Background(), 123, InstanceCloneOptions{Region: "us-east"}, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.ID != 456 {
+		t.Errorf("expected the clone to be returned, got %+v", instance)
+	}
+
+	expected := []InstanceConfigInterface{
+		{Purpose: InterfacePurposeVLAN, Label: "backend", IPAMAddress: "10.0.0.1/24"},
+		{
+			Purpose:  InterfacePurposeVPC,
+			Primary:  true,
+			SubnetID: Pointer(5),
+			IPv4:     &InstanceConfigInterfaceIPv4{NAT1To1: Pointer("any")},
+		},
+	}
+
+	if diff := cmp.Diff(expected, updateBody.Interfaces); diff != "" {
+		t.Errorf("expected the VPC interface to keep its subnet with new addresses: %s", diff)
+	}
+
+	if updateBody.Comments != "kept" {
+		t.Errorf("expected the other settings of the config to be kept, got %+v", updateBody)
+	}
+}
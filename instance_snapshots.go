@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/linode/linodego/internal/parseabletime"
@@ -113,6 +114,30 @@ func (c *Client) CreateInstanceSnapshot(ctx context.Context, linodeID int, label
 	return r.Result().(*InstanceSnapshot), nil
 }
 
+// CreateInstanceSnapshotAndWait creates a snapshot Backup of a Linode and waits for the
+// snapshot event to finish, returning the completed snapshot. It will timeout with an
+// error after timeoutSeconds. See CreateInstanceSnapshot.
+func (c *Client) CreateInstanceSnapshotAndWait(ctx context.Context, linodeID int, label string, timeoutSeconds int) (*InstanceSnapshot, error) {
+	ctx, cancel := c.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	poller, err := c.NewEventPoller(ctx, linodeID, EntityLinode, ActionLinodeSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := c.CreateInstanceSnapshot(ctx, linodeID, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := poller.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		return nil, fmt.Errorf("failed to wait for Instance %d Snapshot %d: %w", linodeID, snapshot.ID, err)
+	}
+
+	return c.GetInstanceSnapshot(ctx, linodeID, snapshot.ID)
+}
+
 // GetInstanceBackups gets the Instance's available Backups.
 // This is not called ListInstanceBackups because a single object is returned, matching the API response.
 func (c *Client) GetInstanceBackups(ctx context.Context, linodeID int) (*InstanceBackupsResponse, error) {
@@ -149,3 +174,35 @@ func (c *Client) RestoreInstanceBackup(ctx context.Context, linodeID int, backup
 	_, err = coupleAPIErrors(c.R(ctx).SetBody(string(body)).Post(e))
 	return err
 }
+
+// GetInstanceBackupPrice returns the hourly and monthly price of enabling backups for the
+// Instance, taking into account the price of the backups addon in the Instance's region.
+func (c *Client) GetInstanceBackupPrice(ctx context.Context, linodeID int) (hourly, monthly float64, err error) {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	linodeType, err := c.GetType(ctx, instance.Type)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if linodeType.Addons == nil || linodeType.Addons.Backups == nil {
+		return 0, 0, fmt.Errorf("type %s has no backups addon", instance.Type)
+	}
+
+	price := linodeType.Addons.Backups.PriceForRegion(instance.Region)
+	if price == nil {
+		return 0, 0, fmt.Errorf("type %s has no backups price for region %s", instance.Type, instance.Region)
+	}
+
+	return float32ToFloat64(price.Hourly), float32ToFloat64(price.Monthly), nil
+}
+
+// float32ToFloat64 converts a price to a float64 without introducing digits
+// that are not present in its shortest float32 representation.
+func float32ToFloat64(value float32) float64 {
+	result, _ := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'f', -1, 32), 64)
+	return result
+}
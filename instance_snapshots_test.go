@@ -0,0 +1,79 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_CreateInstanceSnapshotAndWait(t *testing.T) {
+	created := false
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/account/events":
+			if !created {
+				rw.Write([]byte(`{"data": [{"id": 1, "status": "finished"}], "page": 1, "pages": 1, "results": 1}`))
+				return
+			}
+
+			rw.Write([]byte(`{"data": [{"id": 2, "status": "started"}, {"id": 1, "status": "finished"}], "page": 1, "pages": 1, "results": 2}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/account/events/2":
+			rw.Write([]byte(`{"id": 2, "status": "finished"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/linode/instances/123/backups":
+			created = true
+			rw.Write([]byte(`{"id": 456, "label": "before-upgrade", "status": "pending"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/linode/instances/123/backups/456":
+			rw.Write([]byte(`{"id": 456, "label": "before-upgrade", "status": "successful"}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	snapshot, err := client.CreateInstanceSnapshotAndWait(context.Background(), 123, "before-upgrade", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snapshot.ID != 456 || snapshot.Status != SnapshotSuccessful {
+		t.Errorf("unexpected snapshot: %+v", snapshot)
+	}
+}
+
+func TestClient_GetInstanceBackupPrice(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/instances/123": {http.StatusOK, `{"id": 123, "type": "g6-standard-1", "region": "br-gru"}`},
+		"/v4/linode/instances/456": {http.StatusOK, `{"id": 456, "type": "g6-standard-1", "region": "us-east"}`},
+		"/v4/linode/types/g6-standard-1": {http.StatusOK, `{"id": "g6-standard-1", "addons": {"backups": {
+			"price": {"hourly": 0.003, "monthly": 2},
+			"region_prices": [{"id": "br-gru", "hourly": 0.0042, "monthly": 2.8}]
+		}}}`},
+	})
+	defer ts.Close()
+
+	hourly, monthly, err := client.GetInstanceBackupPrice(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hourly != 0.0042 || monthly != 2.8 {
+		t.Errorf("expected the region price, got %v %v", hourly, monthly)
+	}
+
+	hourly, monthly, err = client.GetInstanceBackupPrice(context.Background(), 456)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hourly != 0.003 || monthly != 2 {
+		t.Errorf("expected the default price, got %v %v", hourly, monthly)
+	}
+}
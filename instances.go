@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -18,6 +19,16 @@ import (
 // InstanceStatus constants start with Instance and include Linode API Instance Status values
 type InstanceStatus string
 
+// InstanceDiskEncryption constants start with InstanceDiskEncryption and include
+// Linode API disk encryption values
+type InstanceDiskEncryption string
+
+// InstanceDiskEncryption constants represent whether an Instance's disks are encrypted
+const (
+	InstanceDiskEncryptionEnabled  InstanceDiskEncryption = "enabled"
+	InstanceDiskEncryptionDisabled InstanceDiskEncryption = "disabled"
+)
+
 // InstanceStatus constants reflect the current status of an Instance
 const (
 	InstanceBooting      InstanceStatus = "booting"
@@ -54,6 +65,27 @@ type Instance struct {
 	Specs           *InstanceSpec   `json:"specs"`
 	WatchdogEnabled bool            `json:"watchdog_enabled"`
 	Tags            []string        `json:"tags"`
+
+	// NOTE: Capabilities and HasUserData are only reported by newer API versions
+	Capabilities []string `json:"capabilities"`
+	HasUserData  bool     `json:"has_user_data"`
+
+	DiskEncryption InstanceDiskEncryption `json:"disk_encryption"`
+
+	// InterfaceGeneration is the networking model of the Instance. Instances using
+	// InterfaceGenerationLinode are managed with ListLinodeInterfaces and related methods.
+	InterfaceGeneration InterfaceGeneration `json:"interface_generation"`
+}
+
+// HasCapability returns true if the Instance reports the given capability
+func (i Instance) HasCapability(capability string) bool {
+	for _, c := range i.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+
+	return false
 }
 
 // InstanceSpec represents a linode spec
@@ -61,6 +93,7 @@ type InstanceSpec struct {
 	Disk     int `json:"disk"`
 	Memory   int `json:"memory"`
 	VCPUs    int `json:"vcpus"`
+	GPUs     int `json:"gpus"`
 	Transfer int `json:"transfer"`
 }
 
@@ -113,11 +146,30 @@ type InstanceCreateOptions struct {
 	PrivateIP       bool                      `json:"private_ip,omitempty"`
 	Tags            []string                  `json:"tags,omitempty"`
 
-	// Creation fields that need to be set explicitly false, "", or 0 use pointers
+	// Metadata is only supported in Regions with the Metadata capability
+	Metadata *InstanceMetadataOptions `json:"metadata,omitempty"`
+
+	// DiskEncryption is only supported in Regions with the Disk Encryption capability
+	DiskEncryption InstanceDiskEncryption `json:"disk_encryption,omitempty"`
+
+	// IPv4 are reserved IPv4 addresses in the Instance's Region to assign to it on creation
+	IPv4 []string `json:"ipv4,omitempty"`
+
+	// Creation fields that need to be set explicitly false, "", or 0 use pointers.
+	// SwapSize is the size in MB of the swap disk created alongside the disk deployed from Image;
+	// CreateInstance checks that both fit in the Type's disk allowance.
 	SwapSize *int  `json:"swap_size,omitempty"`
 	Booted   *bool `json:"booted,omitempty"`
 }
 
+// InstanceMetadataOptions specifies the metadata made available to an Instance
+// through the Metadata service
+type InstanceMetadataOptions struct {
+	// UserData is the base64-encoded user-data (e.g. a cloud-init config) for the Instance.
+	// EncodeUserData can be used to encode it.
+	UserData string `json:"user_data,omitempty"`
+}
+
 // InstanceUpdateOptions is an options struct used when Updating an Instance
 type InstanceUpdateOptions struct {
 	Label           string          `json:"label,omitempty"`
@@ -216,6 +268,17 @@ func (c *Client) ListInstances(ctx context.Context, opts *ListOptions) ([]Instan
 	return response.Data, nil
 }
 
+// ListInstancesByTag lists the Instances that have been assigned the given tag
+func (c *Client) ListInstancesByTag(ctx context.Context, tag string, opts *ListOptions) ([]Instance, error) {
+	return listByTag(ctx, tag, opts, c.ListInstances)
+}
+
+// GetInstances gets the instances with the provided IDs using as few requests as possible.
+// The results are in the order of ids, with nil entries for instances that were not found.
+func (c *Client) GetInstances(ctx context.Context, ids []int) ([]*Instance, error) {
+	return getByIDs(ctx, ids, c.ListInstances, func(v Instance) int { return v.ID })
+}
+
 // GetInstance gets the instance with the provided ID
 func (c *Client) GetInstance(ctx context.Context, linodeID int) (*Instance, error) {
 	e := fmt.Sprintf("linode/instances/%d", linodeID)
@@ -240,6 +303,30 @@ func (c *Client) GetInstanceTransfer(ctx context.Context, linodeID int) (*Instan
 
 // CreateInstance creates a Linode instance
 func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions) (*Instance, error) {
+	if c.labelValidation && opts.Label != "" {
+		if err := ValidateLabel(opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Metadata != nil {
+		if err := c.validateInstanceMetadata(ctx, opts.Region, *opts.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.DiskEncryption == InstanceDiskEncryptionEnabled {
+		if err := c.validateRegionCapability(ctx, opts.Region, RegionCapabilityDiskEncryption); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.SwapSize != nil && opts.Image != "" {
+		if err := c.validateInstanceSwapSize(ctx, opts.Type, opts.Image, *opts.SwapSize); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
@@ -254,8 +341,51 @@ func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions)
 	return r.Result().(*Instance), nil
 }
 
+// validateInstanceMetadata checks that the user-data is base64-encoded and
+// that the Region supports the Metadata service.
+func (c *Client) validateInstanceMetadata(ctx context.Context, regionID string, metadata InstanceMetadataOptions) error {
+	if _, err := DecodeUserData(metadata.UserData); err != nil {
+		return err
+	}
+
+	return c.validateRegionCapability(ctx, regionID, RegionCapabilityMetadata)
+}
+
+// validateInstanceSwapSize checks that a swap disk of swapSize MB fits in the disk
+// allowance of the Linode Type alongside the disk deployed from the Image.
+func (c *Client) validateInstanceSwapSize(ctx context.Context, typeID, imageID string, swapSize int) error {
+	if swapSize < 0 {
+		return fmt.Errorf("swap size must not be negative, got %d", swapSize)
+	}
+
+	linodeType, err := c.GetType(ctx, typeID)
+	if err != nil {
+		return err
+	}
+
+	image, err := c.GetImage(ctx, imageID)
+	if err != nil {
+		return err
+	}
+
+	if image.Size+swapSize > linodeType.Disk {
+		return fmt.Errorf(
+			"a swap disk of %d MB and the %d MB image %s do not fit in the %d MB disk allowance of type %s",
+			swapSize, image.Size, imageID, linodeType.Disk, typeID,
+		)
+	}
+
+	return nil
+}
+
 // UpdateInstance creates a Linode instance
 func (c *Client) UpdateInstance(ctx context.Context, linodeID int, opts InstanceUpdateOptions) (*Instance, error) {
+	if c.labelValidation && opts.Label != "" {
+		if err := ValidateLabel(opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
@@ -301,6 +431,12 @@ func (c *Client) BootInstance(ctx context.Context, linodeID int, configID int) e
 
 // CloneInstance clone an existing Instances Disks and Configuration profiles to another Linode Instance
 func (c *Client) CloneInstance(ctx context.Context, linodeID int, opts InstanceCloneOptions) (*Instance, error) {
+	if c.labelValidation && opts.Label != "" {
+		if err := ValidateLabel(opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
@@ -347,6 +483,8 @@ type InstanceRebuildOptions struct {
 
 // RebuildInstance Deletes all Disks and Configs on this Linode,
 // then deploys a new Image to this Linode with the given attributes.
+// The new config has a single public interface; RebuildInstancePreservingInterfaces keeps
+// the interfaces of the previous config instead.
 func (c *Client) RebuildInstance(ctx context.Context, linodeID int, opts InstanceRebuildOptions) (*Instance, error) {
 	body, err := json.Marshal(opts)
 	if err != nil {
@@ -391,6 +529,79 @@ func (c *Client) ResizeInstance(ctx context.Context, linodeID int, opts Instance
 	return err
 }
 
+// ResizeInstanceClass resizes an instance to the target Linode type, which may be of a different
+// class (e.g. from shared to dedicated CPU), after checking that the type exists, can be created in
+// the instance's region and is not sold out there, and provides enough disk space for the
+// instance's disks. The Type of opts is set to targetType.
+// If AllowAutoDiskResize is not set, it is enabled when the API can resize the disks itself,
+// which requires no more than one data disk and one swap disk.
+func (c *Client) ResizeInstanceClass(ctx context.Context, linodeID int, targetType string, opts InstanceResizeOptions) error {
+	instance, err := c.GetInstance(ctx, linodeID)
+	if err != nil {
+		return err
+	}
+
+	if instance.Type == targetType {
+		return fmt.Errorf("instance %d is already of type %s", linodeID, targetType)
+	}
+
+	linodeType, err := c.GetType(ctx, targetType)
+	if err != nil {
+		if errorCode(err) == http.StatusNotFound {
+			return fmt.Errorf("unknown type %q", targetType)
+		}
+
+		return err
+	}
+
+	if err := c.ValidateTypeRegion(ctx, targetType, instance.Region); err != nil {
+		return err
+	}
+
+	availability, err := c.GetRegionAvailability(ctx, instance.Region)
+	if err != nil {
+		return fmt.Errorf("failed to get availability of region %s: %w", instance.Region, err)
+	}
+
+	for _, a := range availability {
+		if a.Plan == targetType && !a.Available {
+			return fmt.Errorf("type %s is not currently available in region %s", targetType, instance.Region)
+		}
+	}
+
+	disks, err := c.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		return err
+	}
+
+	used, dataDisks, swapDisks := 0, 0, 0
+
+	for _, disk := range disks {
+		used += disk.Size
+
+		if disk.Filesystem == FilesystemSwap {
+			swapDisks++
+		} else {
+			dataDisks++
+		}
+	}
+
+	if used > linodeType.Disk {
+		return fmt.Errorf(
+			"the disks of instance %d use %d MB but type %s provides %d MB, the disks must be resized first",
+			linodeID, used, targetType, linodeType.Disk,
+		)
+	}
+
+	if opts.AllowAutoDiskResize == nil {
+		opts.AllowAutoDiskResize = Pointer(dataDisks <= 1 && swapDisks <= 1)
+	}
+
+	opts.Type = targetType
+
+	return c.ResizeInstance(ctx, linodeID, opts)
+}
+
 // ShutdownInstance - Shutdown an instance
 func (c *Client) ShutdownInstance(ctx context.Context, id int) error {
 	return c.simpleInstanceAction(ctx, "shutdown", id)
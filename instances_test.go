@@ -0,0 +1,268 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_CreateInstance_metadata(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/regions/us-east":  {http.StatusOK, `{"id": "us-east", "capabilities": ["Linodes", "Metadata"]}`},
+		"/v4/regions/us-west":  {http.StatusOK, `{"id": "us-west", "capabilities": ["Linodes"]}`},
+		"/v4/linode/instances": {http.StatusOK, `{"id": 123}`},
+	})
+	defer ts.Close()
+
+	userData, _ := EncodeUserData([]byte("#cloud-config\n"))
+
+	tests := []struct {
+		name      string
+		region    string
+		userData  string
+		expectErr bool
+	}{
+		{"supported region", "us-east", userData, false},
+		{"unsupported region", "us-west", userData, true},
+		{"not base64", "us-east", "#cloud-config", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.CreateInstance(context.Background(), InstanceCreateOptions{
+				Region:   tt.region,
+				Type:     "g6-nanode-1",
+				Metadata: &InstanceMetadataOptions{UserData: tt.userData},
+			})
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestClient_CreateInstance_diskEncryption(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/regions/us-east":  {http.StatusOK, `{"id": "us-east", "capabilities": ["Linodes", "Disk Encryption"]}`},
+		"/v4/regions/us-west":  {http.StatusOK, `{"id": "us-west", "capabilities": ["Linodes"]}`},
+		"/v4/linode/instances": {http.StatusOK, `{"id": 123, "disk_encryption": "enabled"}`},
+	})
+	defer ts.Close()
+
+	instance, err := client.CreateInstance(context.Background(), InstanceCreateOptions{
+		Region: "us-east", Type: "g6-nanode-1", DiskEncryption: InstanceDiskEncryptionEnabled,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.DiskEncryption != InstanceDiskEncryptionEnabled {
+		t.Errorf("expected disk encryption to be enabled, got %s", instance.DiskEncryption)
+	}
+
+	_, err = client.CreateInstance(context.Background(), InstanceCreateOptions{
+		Region: "us-west", Type: "g6-nanode-1", DiskEncryption: InstanceDiskEncryptionEnabled,
+	})
+	if err == nil {
+		t.Fatal("expected an error for a region without disk encryption")
+	}
+}
+
+func TestClient_CreateInstance_swapSize(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/types/g6-nanode-1": {http.StatusOK, `{"id": "g6-nanode-1", "disk": 25600}`},
+		"/v4/images/linode/debian12":   {http.StatusOK, `{"id": "linode/debian12", "size": 1300}`},
+		"/v4/linode/instances":         {http.StatusOK, `{"id": 123}`},
+	})
+	defer ts.Close()
+
+	opts := InstanceCreateOptions{
+		Region: "us-east", Type: "g6-nanode-1", Image: "linode/debian12", SwapSize: Pointer(1024),
+	}
+
+	if _, err := client.CreateInstance(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+
+	opts.SwapSize = Pointer(25000)
+
+	if _, err := client.CreateInstance(context.Background(), opts); err == nil || !strings.Contains(err.Error(), "disk allowance") {
+		t.Fatalf("expected an error for a swap size that does not fit, got %v", err)
+	}
+}
+
+func TestClient_WaitForInstanceStatusWithProgress(t *testing.T) {
+	statuses := []InstanceStatus{InstanceProvisioning, InstanceBooting, InstanceRunning}
+	polls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(rw, `{"id": 123, "status": %q}`, statuses[polls])
+		polls++
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	var observed []InstanceStatus
+
+	_, err := client.WaitForInstanceStatusWithProgress(context.Background(), 123, InstanceRunning, 5, func(current InstanceStatus) {
+		observed = append(observed, current)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(statuses, observed); diff != "" {
+		t.Errorf("unexpected statuses observed: %s", diff)
+	}
+}
+
+func TestClient_WaitForInstanceStatus_lastObserved(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	polls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 2 {
+			cancel()
+		}
+
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"id": 123, "status": "booting"}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	instance, err := client.WaitForInstanceStatus(ctx, 123, InstanceRunning, 5)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the wait to be cancelled, got %v", err)
+	}
+
+	if instance == nil || instance.Status != InstanceBooting {
+		t.Errorf("expected the last observed instance to be returned, got %+v", instance)
+	}
+}
+
+func TestClient_WaitForInstanceReady(t *testing.T) {
+	diskPolls := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "status": "running"}`))
+		case "/v4/linode/instances/123/disks":
+			diskPolls++
+			if diskPolls < 3 {
+				rw.Write([]byte(`{"data": [{"id": 1, "status": "ready"}, {"id": 2, "status": "not ready"}], "page": 1, "pages": 1, "results": 2}`))
+				return
+			}
+
+			rw.Write([]byte(`{"data": [{"id": 1, "status": "ready"}, {"id": 2, "status": "ready"}], "page": 1, "pages": 1, "results": 2}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	instance, err := client.WaitForInstanceReady(context.Background(), 123, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != InstanceRunning || diskPolls != 3 {
+		t.Errorf("expected to wait for the disks after the instance was running, got %s after %d disk polls", instance.Status, diskPolls)
+	}
+}
+
+func TestInstance_specs(t *testing.T) {
+	var instance Instance
+	if err := json.Unmarshal([]byte(`{
+		"id": 123,
+		"specs": {"disk": 81920, "memory": 4096, "vcpus": 2, "gpus": 1, "transfer": 4000}
+	}`), &instance); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &InstanceSpec{Disk: 81920, Memory: 4096, VCPUs: 2, GPUs: 1, Transfer: 4000}
+	if diff := cmp.Diff(expected, instance.Specs); diff != "" {
+		t.Errorf("unexpected specs: %s", diff)
+	}
+}
+
+func TestClient_ResizeInstanceClass(t *testing.T) {
+	var resized string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/linode/instances/123":
+			rw.Write([]byte(`{"id": 123, "type": "g6-standard-2", "region": "us-east"}`))
+		case "/v4/linode/types/g6-dedicated-2":
+			rw.Write([]byte(`{"id": "g6-dedicated-2", "class": "dedicated", "disk": 81920}`))
+		case "/v4/linode/types/g6-nanode-1":
+			rw.Write([]byte(`{"id": "g6-nanode-1", "class": "nanode", "disk": 25600}`))
+		case "/v4/regions/us-east":
+			rw.Write([]byte(`{"id": "us-east", "capabilities": ["Linodes"]}`))
+		case "/v4/regions/us-east/availability":
+			rw.Write([]byte(`[{"region": "us-east", "plan": "g6-dedicated-2", "available": true}]`))
+		case "/v4/linode/instances/123/disks":
+			rw.Write([]byte(`{"data": [
+				{"id": 1, "filesystem": "ext4", "size": 50000},
+				{"id": 2, "filesystem": "swap", "size": 512}
+			], "page": 1, "pages": 1, "results": 2}`))
+		case "/v4/linode/instances/123/resize":
+			body, _ := ioutil.ReadAll(r.Body)
+			resized = string(body)
+			rw.Write([]byte(`{}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	if err := client.ResizeInstanceClass(context.Background(), 123, "g6-dedicated-2", InstanceResizeOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"type":"g6-dedicated-2","allow_auto_disk_resize":true}`; resized != expected {
+		t.Errorf("expected resize request %s but got %s", expected, resized)
+	}
+
+	for typeID, expected := range map[string]string{
+		"g6-nanode-1":   "the disks must be resized first",
+		"g6-standard-2": "already of type",
+		"g6-unknown-1":  `unknown type "g6-unknown-1"`,
+	} {
+		err := client.ResizeInstanceClass(context.Background(), 123, typeID, InstanceResizeOptions{})
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected resizing to %s to fail with %q, got %v", typeID, expected, err)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package parseabletime
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -8,10 +10,30 @@ const (
 	dateLayout = "2006-01-02T15:04:05"
 )
 
+// fallbackLayouts are attempted, in order, when a timestamp does not match dateLayout.
+// Some endpoints include a timezone designator.
+var fallbackLayouts = []string{
+	time.RFC3339Nano,
+}
+
+// ParseableTime is a time.Time that (un)marshals to and from the format used by the Linode API.
 type ParseableTime time.Time
 
 func (p *ParseableTime) UnmarshalJSON(b []byte) error {
-	t, err := time.Parse(`"`+dateLayout+`"`, string(b))
+	if string(b) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("failed to parse timestamp %s: %w", b, err)
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	t, err := Parse(s)
 	if err != nil {
 		return err
 	}
@@ -20,3 +42,28 @@ func (p *ParseableTime) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+func (p ParseableTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Format(time.Time(p)))
+}
+
+// Parse parses a timestamp returned by the Linode API.
+func Parse(s string) (time.Time, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err == nil {
+		return t, nil
+	}
+
+	for _, layout := range fallbackLayouts {
+		if t, layoutErr := time.Parse(layout, s); layoutErr == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+// Format formats the given time in the layout accepted by the Linode API.
+func Format(t time.Time) string {
+	return t.UTC().Format(dateLayout)
+}
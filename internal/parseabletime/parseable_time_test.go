@@ -0,0 +1,51 @@
+package parseabletime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseableTime_UnmarshalJSON(t *testing.T) {
+	expected := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for _, input := range []string{`"2018-01-02T03:04:05"`, `"2018-01-02T03:04:05Z"`, `"2018-01-02T04:04:05+01:00"`} {
+		var p ParseableTime
+		if err := json.Unmarshal([]byte(input), &p); err != nil {
+			t.Fatalf("failed to parse %s: %s", input, err)
+		}
+
+		if !time.Time(p).Equal(expected) {
+			t.Errorf("expected %s to parse as %s, got %s", input, expected, time.Time(p))
+		}
+	}
+
+	for _, input := range []string{`null`, `""`} {
+		p := ParseableTime(expected)
+		if err := json.Unmarshal([]byte(input), &p); err != nil {
+			t.Fatalf("failed to parse %s: %s", input, err)
+		}
+
+		if !time.Time(p).Equal(expected) {
+			t.Errorf("expected %s to leave the time unchanged", input)
+		}
+	}
+
+	var p ParseableTime
+	if err := json.Unmarshal([]byte(`"01/02/2018"`), &p); err == nil {
+		t.Errorf("expected error parsing an invalid timestamp")
+	}
+}
+
+func TestParseableTime_MarshalJSON(t *testing.T) {
+	p := ParseableTime(time.Date(2018, 1, 2, 4, 4, 5, 0, time.FixedZone("", 3600)))
+
+	result, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(result) != `"2018-01-02T03:04:05"` {
+		t.Errorf("unexpected marshalled time: %s", result)
+	}
+}
@@ -2,21 +2,46 @@ package linodego
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/linode/linodego/internal/parseabletime"
 )
 
 // LinodeKernel represents a Linode Instance kernel object
 type LinodeKernel struct {
-	ID           string `json:"id"`
-	Label        string `json:"label"`
-	Version      string `json:"version"`
-	Architecture string `json:"architecture"`
-	Deprecated   bool   `json:"deprecated"`
-	KVM          bool   `json:"kvm"`
-	XEN          bool   `json:"xen"`
-	PVOPS        bool   `json:"pvops"`
+	ID           string     `json:"id"`
+	Label        string     `json:"label"`
+	Version      string     `json:"version"`
+	Architecture string     `json:"architecture"`
+	Deprecated   bool       `json:"deprecated"`
+	KVM          bool       `json:"kvm"`
+	XEN          bool       `json:"xen"`
+	PVOPS        bool       `json:"pvops"`
+	Built        *time.Time `json:"-"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (i *LinodeKernel) UnmarshalJSON(b []byte) error {
+	type Mask LinodeKernel
+
+	p := struct {
+		*Mask
+		Built *parseabletime.ParseableTime `json:"built"`
+	}{
+		Mask: (*Mask)(i),
+	}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+
+	i.Built = (*time.Time)(p.Built)
+
+	return nil
 }
 
 // LinodeKernelsPagedResponse represents a Linode kernels API response for listing
@@ -48,7 +73,7 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedListResponse(endpoint, opts); result != nil {
 		return result.([]LinodeKernel), nil
 	}
 
@@ -57,7 +82,7 @@ func (c *Client) ListKernels(ctx context.Context, opts *ListOptions) ([]LinodeKe
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response.Data, nil)
+	c.addCachedListResponse(endpoint, response.Data, opts, nil)
 
 	return response.Data, nil
 }
@@ -81,3 +106,38 @@ func (c *Client) GetKernel(ctx context.Context, kernelID string) (*LinodeKernel,
 
 	return r.Result().(*LinodeKernel), nil
 }
+
+// ResolveConfigKernel resolves the given kernel ID to the concrete kernel it refers to.
+// Aliases such as "linode/latest-64bit" are resolved to the current kernel with the same
+// version and architecture, while other kernel IDs are returned as-is.
+func (c *Client) ResolveConfigKernel(ctx context.Context, kernelID string) (*LinodeKernel, error) {
+	kernel, err := c.GetKernel(ctx, kernelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isLatestKernelAlias(kernel.ID) {
+		return kernel, nil
+	}
+
+	kernels, err := c.ListKernels(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range kernels {
+		if isLatestKernelAlias(candidate.ID) {
+			continue
+		}
+
+		if candidate.Version == kernel.Version && candidate.Architecture == kernel.Architecture {
+			return &candidate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to resolve kernel %s: no kernel found with version %s (%s)", kernelID, kernel.Version, kernel.Architecture)
+}
+
+func isLatestKernelAlias(kernelID string) bool {
+	return strings.HasPrefix(kernelID, "linode/latest")
+}
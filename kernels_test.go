@@ -0,0 +1,39 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_ResolveConfigKernel(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/kernels/linode/latest-64bit": {http.StatusOK, `{"id": "linode/latest-64bit", "version": "6.2.9", "architecture": "x86_64"}`},
+		"/v4/linode/kernels/linode/grub2":        {http.StatusOK, `{"id": "linode/grub2", "version": "2.06", "architecture": "x86_64"}`},
+		"/v4/linode/kernels": {http.StatusOK, `{"data": [
+			{"id": "linode/latest-64bit", "version": "6.2.9", "architecture": "x86_64"},
+			{"id": "linode/latest-32bit", "version": "6.2.9", "architecture": "i386"},
+			{"id": "linode/6.2.9-x86-linode160", "version": "6.2.9", "architecture": "i386"},
+			{"id": "linode/6.2.9-x86_64-linode160", "version": "6.2.9", "architecture": "x86_64"}
+		], "page": 1, "pages": 1, "results": 4}`},
+	})
+	defer ts.Close()
+
+	kernel, err := client.ResolveConfigKernel(context.Background(), "linode/latest-64bit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kernel.ID != "linode/6.2.9-x86_64-linode160" {
+		t.Errorf("expected latest-64bit to resolve to linode/6.2.9-x86_64-linode160 but got %s", kernel.ID)
+	}
+
+	kernel, err = client.ResolveConfigKernel(context.Background(), "linode/grub2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kernel.ID != "linode/grub2" {
+		t.Errorf("expected linode/grub2 to resolve to itself but got %s", kernel.ID)
+	}
+}
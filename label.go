@@ -0,0 +1,145 @@
+package linodego
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// labelRules are the constraints the API places on the labels of a type of resource
+type labelRules struct {
+	minLength int
+	maxLength int
+
+	// specialChars are the non-alphanumeric characters allowed in the label
+	specialChars string
+
+	// startWithLetter requires labels to begin with a letter
+	startWithLetter bool
+
+	// alphanumericEnds requires labels to begin and end with an alphanumeric character
+	alphanumericEnds bool
+
+	// noRepeatedSpecialChars disallows the same special character twice in a row, e.g. "--"
+	noRepeatedSpecialChars bool
+}
+
+var (
+	instanceLabelRules = labelRules{
+		minLength:              3,
+		maxLength:              64,
+		specialChars:           "-_.",
+		alphanumericEnds:       true,
+		noRepeatedSpecialChars: true,
+	}
+
+	volumeLabelRules = labelRules{
+		minLength:              1,
+		maxLength:              32,
+		specialChars:           "-_",
+		startWithLetter:        true,
+		noRepeatedSpecialChars: true,
+	}
+
+	nodeBalancerLabelRules = labelRules{
+		minLength:    3,
+		maxLength:    32,
+		specialChars: "-_",
+	}
+)
+
+// ValidateLabel checks that label is a valid Instance label: 3 to 64 characters long, made up of
+// alphanumeric characters, hyphens, underscores and periods, beginning and ending with an
+// alphanumeric character, and without two hyphens, underscores or periods in a row.
+// Uniqueness of the label on the Account is only checked by the API.
+func ValidateLabel(label string) error {
+	return instanceLabelRules.validate(label)
+}
+
+// ValidateVolumeLabel checks that label is a valid Volume label: 1 to 32 characters long, made up
+// of alphanumeric characters, hyphens and underscores, beginning with a letter, and without two
+// hyphens or underscores in a row.
+func ValidateVolumeLabel(label string) error {
+	return volumeLabelRules.validate(label)
+}
+
+// ValidateNodeBalancerLabel checks that label is a valid NodeBalancer label: 3 to 32 characters
+// long, made up of alphanumeric characters, hyphens and underscores.
+func ValidateNodeBalancerLabel(label string) error {
+	return nodeBalancerLabelRules.validate(label)
+}
+
+// SetLabelValidation sets whether the labels of Instances, Volumes and NodeBalancers are checked
+// with ValidateLabel, ValidateVolumeLabel and ValidateNodeBalancerLabel before they are created
+// or renamed, so that invalid labels are reported without making a request. It is disabled by default.
+func (c *Client) SetLabelValidation(enabled bool) *Client {
+	c.labelValidation = enabled
+	return c
+}
+
+func (r labelRules) validate(label string) error {
+	if length := utf8.RuneCountInString(label); length < r.minLength || length > r.maxLength {
+		return fmt.Errorf("label %q must be between %d and %d characters long, got %d", label, r.minLength, r.maxLength, length)
+	}
+
+	var (
+		previous rune
+		i        int
+	)
+
+	for _, char := range label {
+		special := strings.ContainsRune(r.specialChars, char)
+
+		if !special && !isAlphanumeric(char) {
+			return fmt.Errorf("label %q contains the invalid character %q at position %d, only %s are allowed", label, char, i, r.allowedDescription())
+		}
+
+		if i == 0 {
+			if r.startWithLetter && !isLetter(char) {
+				return fmt.Errorf("label %q must begin with a letter", label)
+			}
+
+			if r.alphanumericEnds && special {
+				return fmt.Errorf("label %q must begin with an alphanumeric character", label)
+			}
+		}
+
+		if r.noRepeatedSpecialChars && special && char == previous {
+			return fmt.Errorf("label %q must not contain %q", label, string([]rune{char, char}))
+		}
+
+		previous = char
+		i++
+	}
+
+	if r.alphanumericEnds && !isAlphanumeric(previous) {
+		return fmt.Errorf("label %q must end with an alphanumeric character", label)
+	}
+
+	return nil
+}
+
+// allowedDescription describes the characters allowed by the rules, e.g.
+// "alphanumeric characters, hyphens and underscores"
+func (r labelRules) allowedDescription() string {
+	names := map[rune]string{'-': "hyphens", '_': "underscores", '.': "periods"}
+	parts := []string{"alphanumeric characters"}
+
+	for _, char := range r.specialChars {
+		parts = append(parts, names[char])
+	}
+
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
+
+func isLetter(char rune) bool {
+	return (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+}
+
+func isAlphanumeric(char rune) bool {
+	return isLetter(char) || (char >= '0' && char <= '9')
+}
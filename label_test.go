@@ -0,0 +1,59 @@
+package linodego
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValidateLabel(t *testing.T) {
+	tests := []struct {
+		label     string
+		expectErr string
+	}{
+		{"web-01.example_prod", ""},
+		{"ab", "between 3 and 64"},
+		{strings.Repeat("a", 65), "between 3 and 64"},
+		{"web 01", "invalid character ' '"},
+		{"-web", "begin with an alphanumeric"},
+		{"web.", "end with an alphanumeric"},
+		{"web--01", `must not contain "--"`},
+	}
+
+	for _, test := range tests {
+		err := ValidateLabel(test.label)
+
+		switch {
+		case test.expectErr == "" && err != nil:
+			t.Errorf("%q: unexpected error: %s", test.label, err)
+		case test.expectErr != "" && (err == nil || !strings.Contains(err.Error(), test.expectErr)):
+			t.Errorf("%q: expected an error containing %q, got %v", test.label, test.expectErr, err)
+		}
+	}
+}
+
+func TestValidateVolumeLabel(t *testing.T) {
+	if err := ValidateVolumeLabel("data_01"); err != nil {
+		t.Error(err)
+	}
+
+	if err := ValidateVolumeLabel("01-data"); err == nil || !strings.Contains(err.Error(), "begin with a letter") {
+		t.Errorf("expected an error for a label not beginning with a letter, got %v", err)
+	}
+
+	if err := ValidateVolumeLabel("data.01"); err == nil || !strings.Contains(err.Error(), "alphanumeric characters, hyphens and underscores") {
+		t.Errorf("expected an error for a period, got %v", err)
+	}
+}
+
+func TestClient_SetLabelValidation(t *testing.T) {
+	client := NewClient(nil)
+	client.SetBaseURL("http://127.0.0.1:0")
+	client.SetRetryCount(0)
+	client.SetLabelValidation(true)
+
+	_, err := client.CreateInstance(context.Background(), InstanceCreateOptions{Region: "us-east", Type: "g6-nanode-1", Label: "a b"})
+	if err == nil || !strings.Contains(err.Error(), "label") {
+		t.Errorf("expected the label to be rejected before the request, got %v", err)
+	}
+}
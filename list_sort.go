@@ -0,0 +1,220 @@
+package linodego
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// listSortOrder is a field and direction ("asc"/"desc") to sort List results by.
+type listSortOrder struct {
+	field string
+	order string
+}
+
+// SetOrder sets the field and direction ("asc"/"desc") the API should order results by.
+// The order is added to the existing Filter, which is left unchanged if it is not valid JSON.
+// Any secondary orders added with ThenBy are cleared.
+func (l *ListOptions) SetOrder(field, order string) *ListOptions {
+	filter := make(map[string]any)
+	if l.Filter == "" || json.Unmarshal([]byte(l.Filter), &filter) == nil {
+		filter["+order_by"] = field
+		filter["+order"] = order
+
+		if filterBytes, err := json.Marshal(filter); err == nil {
+			l.Filter = string(filterBytes)
+		}
+	}
+
+	l.sortOrders = []listSortOrder{{field, order}}
+
+	return l
+}
+
+// ThenBy adds a secondary field and direction ("asc"/"desc") to order results by.
+// The API only supports ordering by a single field, so secondary orders are applied
+// client-side to the fetched results. Results that share a value for the previous
+// orders are sorted among themselves, which means that when fetching a single page,
+// ties that span page boundaries are only sorted within each page.
+func (l *ListOptions) ThenBy(field, order string) *ListOptions {
+	l.sortOrders = append(l.sortOrders, listSortOrder{field, order})
+	return l
+}
+
+// sortListResults applies the secondary orders of opts to data, a slice of List results.
+// data is expected to already be ordered by the primary order.
+func sortListResults(opts *ListOptions, data any) {
+	if opts == nil || len(opts.sortOrders) < 2 {
+		return
+	}
+
+	slice := reflect.ValueOf(data)
+	if slice.Kind() != reflect.Slice {
+		return
+	}
+
+	sortRuns(slice, opts.sortOrders)
+}
+
+// sortPagedResponse applies the secondary orders of opts to the Data of a PagedResponse.
+func sortPagedResponse(opts *ListOptions, pager PagedResponse) {
+	v := reflect.ValueOf(pager)
+	for v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	if data := v.FieldByName("Data"); data.IsValid() && data.Kind() == reflect.Slice {
+		sortListResults(opts, data.Interface())
+	}
+}
+
+// sortRuns stably sorts each run of elements that share a value for the first order
+// by the remaining orders.
+func sortRuns(slice reflect.Value, orders []listSortOrder) {
+	if len(orders) < 2 || slice.Len() < 2 {
+		return
+	}
+
+	primary := orders[0]
+
+	for start := 0; start < slice.Len(); {
+		end := start + 1
+		for end < slice.Len() && compareListValues(
+			sortFieldValue(slice.Index(start), primary.field),
+			sortFieldValue(slice.Index(end), primary.field),
+		) == 0 {
+			end++
+		}
+
+		run := slice.Slice(start, end)
+		next := orders[1]
+
+		sort.SliceStable(run.Interface(), func(i, j int) bool {
+			result := compareListValues(
+				sortFieldValue(run.Index(i), next.field),
+				sortFieldValue(run.Index(j), next.field),
+			)
+
+			if next.order == Descending {
+				return result > 0
+			}
+
+			return result < 0
+		})
+
+		sortRuns(run, orders[1:])
+
+		start = end
+	}
+}
+
+// sortFieldValue returns the value of the struct field with the given JSON name,
+// falling back to a case-insensitive match against the Go field name for fields
+// that are not directly decoded (e.g. timestamps).
+func sortFieldValue(v reflect.Value, field string) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+
+	t := v.Type()
+	normalized := strings.ReplaceAll(field, "_", "")
+
+	for i := 0; i < t.NumField(); i++ {
+		if name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]; name == field {
+			return v.Field(i)
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if strings.EqualFold(t.Field(i).Name, normalized) {
+			return v.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
+
+// compareListValues compares two values of the same type, returning a negative number
+// if a sorts before b, a positive number if a sorts after b, and zero otherwise.
+// Missing and nil values sort first.
+func compareListValues(a, b reflect.Value) int {
+	for a.IsValid() && a.Kind() == reflect.Ptr {
+		if a.IsNil() {
+			a = reflect.Value{}
+			break
+		}
+
+		a = a.Elem()
+	}
+
+	for b.IsValid() && b.Kind() == reflect.Ptr {
+		if b.IsNil() {
+			b = reflect.Value{}
+			break
+		}
+
+		b = b.Elem()
+	}
+
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		return -1
+	case !b.IsValid():
+		return 1
+	}
+
+	switch a.Kind() {
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareOrdered(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(a.Float(), b.Float())
+	case reflect.Bool:
+		return compareOrdered(boolToInt(a.Bool()), boolToInt(b.Bool()))
+	case reflect.Struct:
+		if at, ok := a.Interface().(time.Time); ok {
+			bt, _ := b.Interface().(time.Time)
+			return compareOrdered(at.UnixNano(), bt.UnixNano())
+		}
+	}
+
+	return 0
+}
+
+func compareOrdered[T int64 | uint64 | float64 | int](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,70 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListOptions_ThenBy(t *testing.T) {
+	var filter string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		filter = r.Header.Get("X-Filter")
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [
+			{"id": 1, "region": "us-east", "label": "b"},
+			{"id": 2, "region": "us-east", "label": "c"},
+			{"id": 3, "region": "us-east", "label": "a"},
+			{"id": 4, "region": "us-west", "label": "z"},
+			{"id": 5, "region": "us-west", "label": "y"}
+		], "page": 1, "pages": 1, "results": 5}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	opts := NewListOptions(0, `{"status": "running"}`).SetOrder("region", Ascending).ThenBy("label", Descending)
+
+	instances, err := client.ListInstances(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"+order":"asc","+order_by":"region","status":"running"}`; filter != expected {
+		t.Errorf("expected filter %s but got %s", expected, filter)
+	}
+
+	ids := make([]int, len(instances))
+	for i, instance := range instances {
+		ids[i] = instance.ID
+	}
+
+	if diff := cmp.Diff([]int{2, 1, 3, 4, 5}, ids); diff != "" {
+		t.Errorf("unexpected order: %s", diff)
+	}
+}
+
+func TestSortListResults_timestamps(t *testing.T) {
+	older := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	events := []Event{
+		{ID: 1, Action: ActionLinodeBoot, Created: &older},
+		{ID: 2, Action: ActionLinodeBoot, Created: &newer},
+		{ID: 3, Action: ActionLinodeBoot},
+	}
+
+	opts := (&ListOptions{}).SetOrder("action", Ascending).ThenBy("created", Descending)
+	sortListResults(opts, events)
+
+	ids := []int{events[0].ID, events[1].ID, events[2].ID}
+	if diff := cmp.Diff([]int{2, 1, 3}, ids); diff != "" {
+		t.Errorf("unexpected order: %s", diff)
+	}
+}
@@ -153,7 +153,7 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedListResponse(endpoint, opts); result != nil {
 		return result.([]LKEVersion), nil
 	}
 
@@ -162,7 +162,7 @@ func (c *Client) ListLKEVersions(ctx context.Context, opts *ListOptions) ([]LKEV
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response.Data, &cacheExpiryTime)
+	c.addCachedListResponse(endpoint, response.Data, opts, &cacheExpiryTime)
 
 	return response.Data, nil
 }
@@ -193,30 +193,10 @@ type LKEClusterAPIEndpointsPagedResponse struct {
 	Data []LKEClusterAPIEndpoint `json:"data"`
 }
 
-// endpoint gets the endpoint URL for LKEClusterAPIEndpointsPagedResponse
-func (LKEClusterAPIEndpointsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("lke/clusters/%d/api-endpoints", id)
-}
-
-func (resp *LKEClusterAPIEndpointsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(LKEClusterAPIEndpointsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*LKEClusterAPIEndpointsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListLKEClusterAPIEndpoints gets the API Endpoint for the LKE Cluster specified
 func (c *Client) ListLKEClusterAPIEndpoints(ctx context.Context, clusterID int, opts *ListOptions) ([]LKEClusterAPIEndpoint, error) {
-	response := LKEClusterAPIEndpointsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, clusterID)
-	if err != nil {
-		return nil, err
-	}
-	return response.Data, nil
+	e := fmt.Sprintf("lke/clusters/%d/api-endpoints", clusterID)
+	return listPaginated[LKEClusterAPIEndpoint](ctx, c, e, opts)
 }
 
 // LKEClustersPagedResponse represents a paginated LKECluster API response
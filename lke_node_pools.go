@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-
-	"github.com/go-resty/resty/v2"
 )
 
 // LKELinodeStatus constants start with LKELinode and include
@@ -49,6 +47,21 @@ type LKENodePool struct {
 	Autoscaler LKENodePoolAutoscaler `json:"autoscaler"`
 }
 
+// IsReady returns true if the LKENodePool has all of its nodes and every node is ready
+func (p LKENodePool) IsReady() bool {
+	if len(p.Linodes) != p.Count {
+		return false
+	}
+
+	for _, node := range p.Linodes {
+		if node.Status != LKELinodeReady {
+			return false
+		}
+	}
+
+	return true
+}
+
 // LKENodePoolCreateOptions fields are those accepted by CreateLKENodePool
 type LKENodePoolCreateOptions struct {
 	Count int               `json:"count"`
@@ -91,31 +104,10 @@ type LKENodePoolsPagedResponse struct {
 	Data []LKENodePool `json:"data"`
 }
 
-// endpoint gets the endpoint URL for InstanceConfigs of a given Instance
-func (LKENodePoolsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("lke/clusters/%d/pools", id)
-}
-
-func (resp *LKENodePoolsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(LKENodePoolsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*LKENodePoolsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListLKENodePools lists LKENodePools
 func (c *Client) ListLKENodePools(ctx context.Context, clusterID int, opts *ListOptions) ([]LKENodePool, error) {
-	response := LKENodePoolsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, clusterID)
-	if err != nil {
-		return nil, err
-	}
-
-	return response.Data, nil
+	e := fmt.Sprintf("lke/clusters/%d/pools", clusterID)
+	return listPaginated[LKENodePool](ctx, c, e, opts)
 }
 
 // GetLKENodePool gets the LKENodePool with the provided ID
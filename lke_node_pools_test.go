@@ -0,0 +1,80 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_WaitForLKENodePoolReady(t *testing.T) {
+	var polls int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		if atomic.AddInt32(&polls, 1) < 3 {
+			rw.Write([]byte(`{"id": 456, "count": 2, "nodes": [{"id": "a", "status": "ready"}, {"id": "b", "status": "not_ready"}]}`))
+			return
+		}
+
+		rw.Write([]byte(`{"id": 456, "count": 2, "nodes": [{"id": "a", "status": "ready"}, {"id": "b", "status": "ready"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	pool, err := client.WaitForLKENodePoolReady(context.Background(), 123, 456, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !pool.IsReady() {
+		t.Errorf("expected the ready pool to be returned, got %+v", pool)
+	}
+
+	if polls != 3 {
+		t.Errorf("expected 3 polls but got %d", polls)
+	}
+}
+
+func TestClient_WaitForLKENodePoolReady_lastObserved(t *testing.T) {
+	var polls int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		if atomic.AddInt32(&polls, 1) > 1 {
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+
+			return
+		}
+
+		rw.Write([]byte(`{"id": 456, "count": 2, "nodes": [{"id": "a", "status": "not_ready"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetPollDelay(1)
+
+	pool, err := client.WaitForLKENodePoolReady(context.Background(), 123, 456, 5)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if pool == nil || pool.ID != 456 || pool.IsReady() {
+		t.Errorf("expected the last observed pool to be returned, got %+v", pool)
+	}
+}
+
+func TestLKENodePool_IsReady(t *testing.T) {
+	scaling := LKENodePool{Count: 3, Linodes: []LKENodePoolLinode{{Status: LKELinodeReady}}}
+	if scaling.IsReady() {
+		t.Error("expected a pool that is missing nodes not to be ready")
+	}
+}
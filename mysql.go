@@ -159,31 +159,10 @@ type MySQLDatabaseBackupsPagedResponse struct {
 	Data []MySQLDatabaseBackup `json:"data"`
 }
 
-func (MySQLDatabaseBackupsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("databases/mysql/instances/%d/backups", id)
-}
-
-func (resp *MySQLDatabaseBackupsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(MySQLDatabaseBackupsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*MySQLDatabaseBackupsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListMySQLDatabaseBackups lists all MySQL Database Backups associated with the given MySQL Database
 func (c *Client) ListMySQLDatabaseBackups(ctx context.Context, databaseID int, opts *ListOptions) ([]MySQLDatabaseBackup, error) {
-	response := MySQLDatabaseBackupsPagedResponse{}
-
-	err := c.listHelper(ctx, &response, opts, databaseID)
-	if err != nil {
-		return nil, err
-	}
-
-	return response.Data, nil
+	e := fmt.Sprintf("databases/mysql/instances/%d/backups", databaseID)
+	return listPaginated[MySQLDatabaseBackup](ctx, c, e, opts)
 }
 
 // GetMySQLDatabase returns a single MySQL Database matching the id
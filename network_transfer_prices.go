@@ -0,0 +1,82 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+)
+
+// NetworkTransferPriceID is the ID of the price of network transfer beyond an Account's transfer pool
+const NetworkTransferPriceID = "network_transfer"
+
+// NetworkTransferTypePrice is the price of network transfer. Hourly is the price per GB.
+type NetworkTransferTypePrice struct {
+	Hourly  float64 `json:"hourly"`
+	Monthly float64 `json:"monthly"`
+}
+
+// NetworkTransferTypeRegionPrice is the price of network transfer in a specific region
+type NetworkTransferTypeRegionPrice struct {
+	ID      string  `json:"id"`
+	Hourly  float64 `json:"hourly"`
+	Monthly float64 `json:"monthly"`
+}
+
+// NetworkTransferPrice represents the price of a type of network transfer
+type NetworkTransferPrice struct {
+	ID           string                           `json:"id"`
+	Label        string                           `json:"label"`
+	Price        *NetworkTransferTypePrice        `json:"price"`
+	RegionPrices []NetworkTransferTypeRegionPrice `json:"region_prices"`
+	Transfer     int                              `json:"transfer"`
+}
+
+// PricePerGB returns the price per GB of network transfer from the given region,
+// which is the region's price override if there is one.
+func (p NetworkTransferPrice) PricePerGB(region string) (float64, bool) {
+	for _, price := range p.RegionPrices {
+		if price.ID == region {
+			return price.Hourly, true
+		}
+	}
+
+	if p.Price == nil {
+		return 0, false
+	}
+
+	return p.Price.Hourly, true
+}
+
+// ListNetworkTransferPrices lists the prices of network transfer
+func (c *Client) ListNetworkTransferPrices(ctx context.Context, opts *ListOptions) ([]NetworkTransferPrice, error) {
+	return listPaginated[NetworkTransferPrice](ctx, c, "network-transfer/prices", opts)
+}
+
+// EstimateMigrationTransferCost estimates the cost of transferring gb GB of data from
+// sourceRegion to destRegion, such as when cloning or migrating resources between regions.
+// The API only provides the price of outbound transfer beyond the Account's transfer pool,
+// which depends on the source region; it has no pricing specific to transfer between regions.
+// The estimate is therefore the cost if none of the transfer is covered by the pool.
+// An error wrapping ErrNotSupported is returned if the API provides no transfer price
+// for the source region.
+func (c *Client) EstimateMigrationTransferCost(ctx context.Context, sourceRegion, destRegion string, gb int) (float64, error) {
+	if gb < 0 {
+		return 0, fmt.Errorf("transfer size must not be negative, got %d", gb)
+	}
+
+	prices, err := c.ListNetworkTransferPrices(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, price := range prices {
+		if price.ID != NetworkTransferPriceID {
+			continue
+		}
+
+		if perGB, ok := price.PricePerGB(sourceRegion); ok {
+			return perGB * float64(gb), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: no network transfer price for transfer from %s to %s", ErrNotSupported, sourceRegion, destRegion)
+}
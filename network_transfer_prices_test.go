@@ -0,0 +1,43 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_EstimateMigrationTransferCost(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/network-transfer/prices", "application/json", `{"data": [
+		{"id": "distributed_network_transfer", "price": {"hourly": 0.01, "monthly": null}, "region_prices": []},
+		{"id": "network_transfer", "price": {"hourly": 0.005, "monthly": null}, "region_prices": [{"id": "br-gru", "hourly": 0.015, "monthly": null}]}
+	], "page": 1, "pages": 1, "results": 2}`, http.StatusOK)
+	defer ts.Close()
+
+	cost, err := client.EstimateMigrationTransferCost(context.Background(), "us-east", "eu-central", 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cost != 1 {
+		t.Errorf("expected the default price, got %v", cost)
+	}
+
+	cost, err = client.EstimateMigrationTransferCost(context.Background(), "br-gru", "us-east", 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cost != 3 {
+		t.Errorf("expected the region price, got %v", cost)
+	}
+}
+
+func TestClient_EstimateMigrationTransferCost_notSupported(t *testing.T) {
+	ts, client := createTestServer(http.MethodGet, "/v4/network-transfer/prices", "application/json", `{"data": [], "page": 1, "pages": 1, "results": 0}`, http.StatusOK)
+	defer ts.Close()
+
+	if _, err := client.EstimateMigrationTransferCost(context.Background(), "us-east", "eu-central", 200); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("expected ErrNotSupported, got %v", err)
+	}
+}
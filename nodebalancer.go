@@ -53,6 +53,18 @@ type NodeBalancerCreateOptions struct {
 	ClientConnThrottle *int                               `json:"client_conn_throttle,omitempty"`
 	Configs            []*NodeBalancerConfigCreateOptions `json:"configs,omitempty"`
 	Tags               []string                           `json:"tags"`
+
+	// VPC subnets the NodeBalancer should be attached to, allowing it to route traffic to nodes within them
+	VPCs []NodeBalancerVPCOptions `json:"vpcs,omitempty"`
+}
+
+// NodeBalancerVPCOptions attaches a NodeBalancer to a VPC subnet when creating it
+type NodeBalancerVPCOptions struct {
+	SubnetID int `json:"subnet_id"`
+
+	// The IPv4 range within the subnet the NodeBalancer's addresses are allocated from.
+	// If empty, a range is automatically assigned.
+	IPv4Range string `json:"ipv4_range,omitempty"`
 }
 
 // NodeBalancerUpdateOptions are the options permitted for UpdateNodeBalancer
@@ -133,6 +145,11 @@ func (c *Client) ListNodeBalancers(ctx context.Context, opts *ListOptions) ([]No
 	return response.Data, nil
 }
 
+// ListNodeBalancersByTag lists the NodeBalancers that have been assigned the given tag
+func (c *Client) ListNodeBalancersByTag(ctx context.Context, tag string, opts *ListOptions) ([]NodeBalancer, error) {
+	return listByTag(ctx, tag, opts, c.ListNodeBalancers)
+}
+
 // GetNodeBalancer gets the NodeBalancer with the provided ID
 func (c *Client) GetNodeBalancer(ctx context.Context, nodebalancerID int) (*NodeBalancer, error) {
 	e := fmt.Sprintf("nodebalancers/%d", nodebalancerID)
@@ -146,6 +163,12 @@ func (c *Client) GetNodeBalancer(ctx context.Context, nodebalancerID int) (*Node
 
 // CreateNodeBalancer creates a NodeBalancer
 func (c *Client) CreateNodeBalancer(ctx context.Context, opts NodeBalancerCreateOptions) (*NodeBalancer, error) {
+	if c.labelValidation && opts.Label != nil {
+		if err := ValidateNodeBalancerLabel(*opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
@@ -162,6 +185,12 @@ func (c *Client) CreateNodeBalancer(ctx context.Context, opts NodeBalancerCreate
 
 // UpdateNodeBalancer updates the NodeBalancer with the specified id
 func (c *Client) UpdateNodeBalancer(ctx context.Context, nodebalancerID int, opts NodeBalancerUpdateOptions) (*NodeBalancer, error) {
+	if c.labelValidation && opts.Label != nil {
+		if err := ValidateNodeBalancerLabel(*opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-
-	"github.com/go-resty/resty/v2"
 )
 
 // NodeBalancerNode objects represent a backend that can accept traffic for a NodeBalancer Config
@@ -18,6 +16,9 @@ type NodeBalancerNode struct {
 	Mode           NodeMode `json:"mode"`
 	ConfigID       int      `json:"config_id"`
 	NodeBalancerID int      `json:"nodebalancer_id"`
+
+	// The ID of the NodeBalancer VPC configuration of the node, if its address is within a VPC subnet
+	VPCConfigID int `json:"vpc_config_id"`
 }
 
 // NodeMode is the mode a NodeBalancer should use when sending traffic to a NodeBalancer Node
@@ -43,6 +44,9 @@ type NodeBalancerNodeCreateOptions struct {
 	Label   string   `json:"label"`
 	Weight  int      `json:"weight,omitempty"`
 	Mode    NodeMode `json:"mode,omitempty"`
+
+	// The ID of the VPC subnet the node's address belongs to, for nodes within a VPC
+	SubnetID int `json:"subnet_id,omitempty"`
 }
 
 // NodeBalancerNodeUpdateOptions fields are those accepted by UpdateNodeBalancerNode
@@ -51,6 +55,9 @@ type NodeBalancerNodeUpdateOptions struct {
 	Label   string   `json:"label,omitempty"`
 	Weight  int      `json:"weight,omitempty"`
 	Mode    NodeMode `json:"mode,omitempty"`
+
+	// The ID of the VPC subnet the node's address belongs to, for nodes within a VPC
+	SubnetID int `json:"subnet_id,omitempty"`
 }
 
 // GetCreateOptions converts a NodeBalancerNode to NodeBalancerNodeCreateOptions for use in CreateNodeBalancerNode
@@ -79,31 +86,10 @@ type NodeBalancerNodesPagedResponse struct {
 	Data []NodeBalancerNode `json:"data"`
 }
 
-// endpoint gets the endpoint URL for NodeBalancerNode
-func (NodeBalancerNodesPagedResponse) endpoint(ids ...any) string {
-	nodebalancerID := ids[0].(int)
-	configID := ids[1].(int)
-	return fmt.Sprintf("nodebalancers/%d/configs/%d/nodes", nodebalancerID, configID)
-}
-
-func (resp *NodeBalancerNodesPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(NodeBalancerNodesPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*NodeBalancerNodesPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListNodeBalancerNodes lists NodeBalancerNodes
 func (c *Client) ListNodeBalancerNodes(ctx context.Context, nodebalancerID int, configID int, opts *ListOptions) ([]NodeBalancerNode, error) {
-	response := NodeBalancerNodesPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, nodebalancerID, configID)
-	if err != nil {
-		return nil, err
-	}
-	return response.Data, nil
+	e := fmt.Sprintf("nodebalancers/%d/configs/%d/nodes", nodebalancerID, configID)
+	return listPaginated[NodeBalancerNode](ctx, c, e, opts)
 }
 
 // GetNodeBalancerNode gets the template with the provided ID
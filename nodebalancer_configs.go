@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-
-	"github.com/go-resty/resty/v2"
 )
 
 // NodeBalancerConfig objects allow a NodeBalancer to accept traffic on a new port
@@ -211,30 +209,10 @@ type NodeBalancerConfigsPagedResponse struct {
 	Data []NodeBalancerConfig `json:"data"`
 }
 
-// endpoint gets the endpoint URL for NodeBalancerConfig
-func (NodeBalancerConfigsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("nodebalancers/%d/configs", id)
-}
-
-func (resp *NodeBalancerConfigsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(NodeBalancerConfigsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*NodeBalancerConfigsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListNodeBalancerConfigs lists NodeBalancerConfigs
 func (c *Client) ListNodeBalancerConfigs(ctx context.Context, nodebalancerID int, opts *ListOptions) ([]NodeBalancerConfig, error) {
-	response := NodeBalancerConfigsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, nodebalancerID)
-	if err != nil {
-		return nil, err
-	}
-	return response.Data, nil
+	e := fmt.Sprintf("nodebalancers/%d/configs", nodebalancerID)
+	return listPaginated[NodeBalancerConfig](ctx, c, e, opts)
 }
 
 // GetNodeBalancerConfig gets the template with the provided ID
@@ -0,0 +1,77 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_CreateNodeBalancer_vpc(t *testing.T) {
+	var body map[string]any
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/nodebalancers":
+			raw, _ := ioutil.ReadAll(r.Body)
+			if err := json.Unmarshal(raw, &body); err != nil {
+				t.Error(err)
+			}
+
+			rw.Write([]byte(`{"id": 123, "region": "us-east", "client_conn_throttle": 10}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/nodebalancers/123/vpcs":
+			rw.Write([]byte(`{"data": [{"id": 7, "ipv4_range": "10.0.0.4/30", "nodebalancer_id": 123, "subnet_id": 456, "vpc_id": 789}], "page": 1, "pages": 1, "results": 1}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	nodeBalancer, err := client.CreateNodeBalancer(context.Background(), NodeBalancerCreateOptions{
+		Region:             "us-east",
+		ClientConnThrottle: Pointer(10),
+		VPCs:               []NodeBalancerVPCOptions{{SubnetID: 456}},
+		Configs: []*NodeBalancerConfigCreateOptions{{
+			Port:  80,
+			Nodes: []NodeBalancerNodeCreateOptions{{Address: "10.0.0.10:80", Label: "backend", SubnetID: 456}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nodeBalancer.ClientConnThrottle != 10 {
+		t.Errorf("unexpected client_conn_throttle: %d", nodeBalancer.ClientConnThrottle)
+	}
+
+	if body["client_conn_throttle"] != float64(10) {
+		t.Errorf("expected client_conn_throttle to be sent, got %v", body)
+	}
+
+	vpcs, _ := body["vpcs"].([]any)
+	if len(vpcs) != 1 || vpcs[0].(map[string]any)["subnet_id"] != float64(456) {
+		t.Errorf("unexpected vpcs: %v", body["vpcs"])
+	}
+
+	configs, _ := body["configs"].([]any)
+	nodes, _ := configs[0].(map[string]any)["nodes"].([]any)
+	if len(nodes) != 1 || nodes[0].(map[string]any)["subnet_id"] != float64(456) {
+		t.Errorf("unexpected nodes: %v", configs[0])
+	}
+
+	vpcConfigs, err := client.ListNodeBalancerVPCConfigs(context.Background(), 123, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vpcConfigs) != 1 || vpcConfigs[0].SubnetID != 456 || vpcConfigs[0].VPCID != 789 {
+		t.Errorf("unexpected VPC configs: %+v", vpcConfigs)
+	}
+}
@@ -0,0 +1,25 @@
+package linodego
+
+import (
+	"context"
+)
+
+// NodeBalancerType represents the pricing of NodeBalancers
+type NodeBalancerType struct {
+	ID           string              `json:"id"`
+	Label        string              `json:"label"`
+	Price        *LinodePrice        `json:"price"`
+	RegionPrices []LinodeRegionPrice `json:"region_prices"`
+	Transfer     int                 `json:"transfer"`
+}
+
+// PriceForRegion returns the price of a NodeBalancer in the given region,
+// which is the region's price override if there is one.
+func (t NodeBalancerType) PriceForRegion(region string) *LinodePrice {
+	return regionPrice(t.Price, t.RegionPrices, region)
+}
+
+// ListNodeBalancerTypes lists the NodeBalancer types and their prices
+func (c *Client) ListNodeBalancerTypes(ctx context.Context, opts *ListOptions) ([]NodeBalancerType, error) {
+	return listPaginated[NodeBalancerType](ctx, c, "nodebalancers/types", opts)
+}
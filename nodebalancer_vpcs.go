@@ -0,0 +1,32 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+)
+
+// NodeBalancerVPCConfig represents the attachment of a NodeBalancer to a VPC subnet
+type NodeBalancerVPCConfig struct {
+	ID             int    `json:"id"`
+	IPv4Range      string `json:"ipv4_range"`
+	NodeBalancerID int    `json:"nodebalancer_id"`
+	SubnetID       int    `json:"subnet_id"`
+	VPCID          int    `json:"vpc_id"`
+}
+
+// ListNodeBalancerVPCConfigs lists the VPC configurations of a NodeBalancer
+func (c *Client) ListNodeBalancerVPCConfigs(ctx context.Context, nodebalancerID int, opts *ListOptions) ([]NodeBalancerVPCConfig, error) {
+	e := fmt.Sprintf("nodebalancers/%d/vpcs", nodebalancerID)
+	return listPaginated[NodeBalancerVPCConfig](ctx, c, e, opts)
+}
+
+// GetNodeBalancerVPCConfig gets the NodeBalancer VPC configuration with the provided ID
+func (c *Client) GetNodeBalancerVPCConfig(ctx context.Context, nodebalancerID int, vpcConfigID int) (*NodeBalancerVPCConfig, error) {
+	e := fmt.Sprintf("nodebalancers/%d/vpcs/%d", nodebalancerID, vpcConfigID)
+	req := c.R(ctx).SetResult(&NodeBalancerVPCConfig{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+	return r.Result().(*NodeBalancerVPCConfig), nil
+}
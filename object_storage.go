@@ -27,3 +27,30 @@ func (c *Client) GetObjectStorageTransfer(ctx context.Context) (*ObjectStorageTr
 
 	return r.Result().(*ObjectStorageTransfer), nil
 }
+
+// ObjectStorageUsage is the usage of Object Storage within a region or cluster
+type ObjectStorageUsage struct {
+	Region  string
+	Buckets int
+	Objects int
+	Size    int
+}
+
+// GetObjectStorageUsageByRegion returns the number of buckets and objects and the
+// total size in bytes of Object Storage used in the given region or cluster.
+// The usage is aggregated from the size and object counts reported for each bucket.
+func (c *Client) GetObjectStorageUsageByRegion(ctx context.Context, clusterOrRegionID string) (*ObjectStorageUsage, error) {
+	buckets, err := c.ListObjectStorageBucketsInCluster(ctx, nil, clusterOrRegionID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &ObjectStorageUsage{Region: clusterOrRegionID, Buckets: len(buckets)}
+
+	for _, bucket := range buckets {
+		usage.Objects += bucket.Objects
+		usage.Size += bucket.Size
+	}
+
+	return usage, nil
+}
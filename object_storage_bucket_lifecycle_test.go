@@ -0,0 +1,97 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_ObjectStorageBucketLifecycle(t *testing.T) {
+	var stored []byte
+
+	ts, client, _ := createObjectStorageS3TestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my-bucket" || !r.URL.Query().Has("lifecycle") {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			if r.Header.Get("Content-MD5") == "" {
+				rw.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			stored, _ = ioutil.ReadAll(r.Body)
+		case http.MethodDelete:
+			stored = nil
+		case http.MethodGet:
+			if stored == nil {
+				rw.WriteHeader(http.StatusNotFound)
+				rw.Write([]byte(`<Error><Code>NoSuchLifecycleConfiguration</Code></Error>`))
+
+				return
+			}
+
+			rw.Write(stored)
+		}
+	})
+	defer ts.Close()
+
+	rules, err := client.GetObjectStorageBucketLifecycle(context.Background(), "us-east-1", "my-bucket", ObjectStorageS3Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rules) != 0 {
+		t.Fatalf("expected no rules, got %v", rules)
+	}
+
+	expected := []ObjectStorageLifecycleRule{
+		{ID: "logs", Prefix: "logs/", Status: ObjectStorageLifecycleRuleEnabled, ExpirationDays: 30},
+		{ID: "versions", Status: ObjectStorageLifecycleRuleDisabled, NoncurrentVersionExpirationDays: 7},
+	}
+
+	if err := client.SetObjectStorageBucketLifecycle(context.Background(), "us-east-1", "my-bucket", expected, ObjectStorageS3Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err = client.GetObjectStorageBucketLifecycle(context.Background(), "us-east-1", "my-bucket", ObjectStorageS3Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expected, rules); diff != "" {
+		t.Errorf("unexpected rules: %s", diff)
+	}
+
+	if err := client.SetObjectStorageBucketLifecycle(context.Background(), "us-east-1", "my-bucket", nil, ObjectStorageS3Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if stored != nil {
+		t.Error("expected the lifecycle configuration to be deleted")
+	}
+}
+
+func TestClient_GetObjectStorageBucketLifecycle_noSuchBucket(t *testing.T) {
+	ts, client, _ := createObjectStorageS3TestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist.</Message></Error>`))
+	})
+	defer ts.Close()
+
+	rules, err := client.GetObjectStorageBucketLifecycle(context.Background(), "us-east-1", "my-bukcet", ObjectStorageS3Options{})
+	if !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "NoSuchBucket") {
+		t.Fatalf("expected a NoSuchBucket error, got %v", err)
+	}
+
+	if rules != nil {
+		t.Errorf("expected no rules, got %v", rules)
+	}
+}
@@ -0,0 +1,154 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// objectStorageS3TestKeys records the temporary keys of a server returned by createObjectStorageS3TestServer
+type objectStorageS3TestKeys struct {
+	created    ObjectStorageKeyCreateOptions
+	deleted    bool
+	failDelete bool
+}
+
+// createObjectStorageS3TestServer returns a server that serves both the cluster and temporary
+// key endpoints of the Linode API and the given S3 handler. The server is the S3 endpoint of the
+// us-east-1 cluster and of the us-east region.
+func createObjectStorageS3TestServer(t *testing.T, s3 http.HandlerFunc) (*httptest.Server, *Client, *objectStorageS3TestKeys) {
+	t.Helper()
+
+	var (
+		ts   *httptest.Server
+		keys objectStorageS3TestKeys
+	)
+
+	ts = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/object-storage/clusters":
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"data": [{"id": "us-east-1", "region": "us-east", "domain": "` + ts.URL + `"}], "page": 1, "pages": 1, "results": 1}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/object-storage/keys":
+			json.NewDecoder(r.Body).Decode(&keys.created)
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{"id": 1, "access_key": "access", "secret_key": "secret", "regions": [{"id": "us-east", "s3_endpoint": "` + ts.URL + `"}]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v4/object-storage/keys/1":
+			if keys.failDelete {
+				rw.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			keys.deleted = true
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Write([]byte(`{}`))
+		case strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/"):
+			s3(rw, r)
+		default:
+			rw.WriteHeader(http.StatusForbidden)
+		}
+	}))
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryCount(0)
+
+	return ts, &client, &keys
+}
+
+func TestClient_ObjectStorageBucketVersioning(t *testing.T) {
+	var stored string
+
+	ts, client, keys := createObjectStorageS3TestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my-bucket" || !r.URL.Query().Has("versioning") {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			stored = string(body)
+		case http.MethodGet:
+			rw.Write([]byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`))
+		}
+	})
+	defer ts.Close()
+
+	if err := client.SetObjectStorageBucketVersioning(context.Background(), "us-east", "my-bucket", true, ObjectStorageS3Options{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`; stored != expected {
+		t.Errorf("expected %s but got %s", expected, stored)
+	}
+
+	versioning, err := client.GetObjectStorageBucketVersioning(context.Background(), "us-east", "my-bucket", ObjectStorageS3Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !versioning.Enabled() {
+		t.Errorf("expected versioning to be enabled, got %q", versioning.Status)
+	}
+
+	if !keys.deleted {
+		t.Error("expected the temporary key to be deleted")
+	}
+
+	access := keys.created.BucketAccess
+	if access == nil || len(*access) != 1 || (*access)[0].Region != "us-east" || (*access)[0].BucketName != "my-bucket" ||
+		len(keys.created.Regions) != 1 || keys.created.Regions[0] != "us-east" {
+		t.Errorf("expected the temporary key to be limited to the bucket, got %+v", keys.created)
+	}
+}
+
+func TestClient_ObjectStorageBucketVersioning_cleanupError(t *testing.T) {
+	ts, client, keys := createObjectStorageS3TestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`))
+	})
+	defer ts.Close()
+
+	keys.failDelete = true
+
+	versioning, err := client.GetObjectStorageBucketVersioning(context.Background(), "us-east-1", "my-bucket", ObjectStorageS3Options{})
+	if err == nil || !strings.Contains(err.Error(), "failed to delete temporary object storage key 1") {
+		t.Fatalf("expected the failure to delete the temporary key to be returned, got %v", err)
+	}
+
+	if versioning != nil {
+		t.Errorf("expected no versioning to be returned, got %+v", versioning)
+	}
+
+	if access := keys.created.BucketAccess; access == nil || (*access)[0].Cluster != "us-east-1" || len(keys.created.Regions) != 0 {
+		t.Errorf("expected the temporary key to be limited to the bucket in its cluster, got %+v", keys.created)
+	}
+}
+
+func TestClient_ObjectStorageBucketVersioning_credentials(t *testing.T) {
+	ts, client, keys := createObjectStorageS3TestServer(t, func(rw http.ResponseWriter, r *http.Request) {
+		rw.Write([]byte(`<VersioningConfiguration><Status>Suspended</Status></VersioningConfiguration>`))
+	})
+	defer ts.Close()
+
+	versioning, err := client.GetObjectStorageBucketVersioning(context.Background(), "us-east", "my-bucket", ObjectStorageS3Options{
+		Endpoint:  ts.URL,
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if versioning.Status != ObjectStorageBucketVersioningSuspended {
+		t.Errorf("expected versioning to be suspended, got %q", versioning.Status)
+	}
+
+	if keys.created.Label != "" {
+		t.Errorf("expected no temporary key to be created, got %+v", keys.created)
+	}
+}
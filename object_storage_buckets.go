@@ -81,12 +81,8 @@ type ObjectStorageBucketsPagedResponse struct {
 }
 
 // endpoint gets the endpoint URL for ObjectStorageBucket
-func (ObjectStorageBucketsPagedResponse) endpoint(args ...any) string {
-	endpoint := "object-storage/buckets"
-	if len(args) > 0 {
-		endpoint = fmt.Sprintf(endpoint+"/%s", args[0])
-	}
-	return endpoint
+func (ObjectStorageBucketsPagedResponse) endpoint(_ ...any) string {
+	return "object-storage/buckets"
 }
 
 func (resp *ObjectStorageBucketsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
@@ -111,12 +107,8 @@ func (c *Client) ListObjectStorageBuckets(ctx context.Context, opts *ListOptions
 
 // ListObjectStorageBucketsInCluster lists all ObjectStorageBuckets of a cluster
 func (c *Client) ListObjectStorageBucketsInCluster(ctx context.Context, opts *ListOptions, clusterID string) ([]ObjectStorageBucket, error) {
-	response := ObjectStorageBucketsPagedResponse{}
-	err := c.listHelper(ctx, &response, opts, clusterID)
-	if err != nil {
-		return nil, err
-	}
-	return response.Data, nil
+	e := fmt.Sprintf("object-storage/buckets/%s", clusterID)
+	return listPaginated[ObjectStorageBucket](ctx, c, e, opts)
 }
 
 // GetObjectStorageBucket gets the ObjectStorageBucket with the provided label
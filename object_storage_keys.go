@@ -16,11 +16,20 @@ type ObjectStorageKey struct {
 	SecretKey    string                          `json:"secret_key"`
 	Limited      bool                            `json:"limited"`
 	BucketAccess *[]ObjectStorageKeyBucketAccess `json:"bucket_access"`
+	Regions      []ObjectStorageKeyRegion        `json:"regions"`
+}
+
+// ObjectStorageKeyRegion represents a region an object storage key is valid in,
+// along with the S3 endpoint hostname to use for that region
+type ObjectStorageKeyRegion struct {
+	ID         string `json:"id"`
+	S3Endpoint string `json:"s3_endpoint"`
 }
 
 // ObjectStorageKeyBucketAccess represents a linode limited object storage key's bucket access
 type ObjectStorageKeyBucketAccess struct {
-	Cluster     string `json:"cluster"`
+	Cluster     string `json:"cluster,omitempty"`
+	Region      string `json:"region,omitempty"`
 	BucketName  string `json:"bucket_name"`
 	Permissions string `json:"permissions"`
 }
@@ -29,11 +38,28 @@ type ObjectStorageKeyBucketAccess struct {
 type ObjectStorageKeyCreateOptions struct {
 	Label        string                          `json:"label"`
 	BucketAccess *[]ObjectStorageKeyBucketAccess `json:"bucket_access"`
+	Regions      []string                        `json:"regions,omitempty"`
 }
 
 // ObjectStorageKeyUpdateOptions fields are those accepted by UpdateObjectStorageKey
 type ObjectStorageKeyUpdateOptions struct {
-	Label string `json:"label"`
+	Label   string   `json:"label"`
+	Regions []string `json:"regions,omitempty"`
+}
+
+// S3EndpointForRegion returns the S3 endpoint hostname this key should use for the given region
+func (k ObjectStorageKey) S3EndpointForRegion(region string) (string, error) {
+	for _, r := range k.Regions {
+		if r.ID == region {
+			if r.S3Endpoint == "" {
+				return "", fmt.Errorf("object storage key %d has no S3 endpoint for region %s", k.ID, region)
+			}
+
+			return r.S3Endpoint, nil
+		}
+	}
+
+	return "", fmt.Errorf("object storage key %d is not valid in region %s", k.ID, region)
 }
 
 // ObjectStorageKeysPagedResponse represents a linode API response for listing
@@ -116,3 +142,89 @@ func (c *Client) DeleteObjectStorageKey(ctx context.Context, keyID int) error {
 	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
 	return err
 }
+
+// IsRegionScoped returns whether the key is scoped by region rather than by cluster.
+// Keys created before Object Storage regions were introduced are scoped by cluster,
+// so false is returned for them until they are migrated, see MigrateObjectStorageKeyToRegions.
+func (k ObjectStorageKey) IsRegionScoped() bool {
+	if len(k.Regions) > 0 {
+		return true
+	}
+
+	if k.BucketAccess != nil {
+		for _, access := range *k.BucketAccess {
+			if access.Region != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// MigrateObjectStorageKeyToRegions scopes the Object Storage key with the given ID to regions.
+// Unlimited keys are updated in place. The bucket access of limited keys cannot be changed
+// by the API, so a new key with the same label is created instead, with its bucket access
+// scoped to the region of each bucket's cluster. The existing key is left in place so it can
+// be deleted once its users have switched to the returned key.
+// An error is returned if a bucket the key has access to is not in one of regions.
+func (c *Client) MigrateObjectStorageKeyToRegions(ctx context.Context, keyID int, regions []string) (*ObjectStorageKey, error) {
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("at least one region is required to migrate object storage key %d", keyID)
+	}
+
+	key, err := c.GetObjectStorageKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.Limited || key.BucketAccess == nil {
+		return c.UpdateObjectStorageKey(ctx, keyID, ObjectStorageKeyUpdateOptions{
+			Label:   key.Label,
+			Regions: regions,
+		})
+	}
+
+	clusters, err := c.ListObjectStorageClusters(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterRegions := make(map[string]string, len(clusters))
+	for _, cluster := range clusters {
+		clusterRegions[cluster.ID] = cluster.Region
+	}
+
+	keyRegions := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		keyRegions[region] = true
+	}
+
+	bucketAccess := make([]ObjectStorageKeyBucketAccess, len(*key.BucketAccess))
+
+	for i, access := range *key.BucketAccess {
+		region := access.Region
+		if region == "" {
+			var ok bool
+			if region, ok = clusterRegions[access.Cluster]; !ok {
+				return nil, fmt.Errorf("object storage cluster %s of bucket %s was not found", access.Cluster, access.BucketName)
+			}
+		}
+
+		if !keyRegions[region] {
+			return nil, fmt.Errorf("bucket %s is in region %s, which is not one of the key's regions", access.BucketName, region)
+		}
+
+		bucketAccess[i] = ObjectStorageKeyBucketAccess{
+			Region:      region,
+			BucketName:  access.BucketName,
+			Permissions: access.Permissions,
+		}
+	}
+
+	return c.CreateObjectStorageKey(ctx, ObjectStorageKeyCreateOptions{
+		Label:        key.Label,
+		BucketAccess: &bucketAccess,
+		Regions:      regions,
+	})
+}
@@ -0,0 +1,104 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_MigrateObjectStorageKeyToRegions(t *testing.T) {
+	var created ObjectStorageKeyCreateOptions
+
+	var updated ObjectStorageKeyUpdateOptions
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/object-storage/keys/1":
+			rw.Write([]byte(`{"id": 1, "label": "unlimited", "limited": false}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v4/object-storage/keys/1":
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &updated)
+			rw.Write([]byte(`{"id": 1, "label": "unlimited", "regions": [{"id": "us-east", "s3_endpoint": "us-east-1.linodeobjects.com"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/object-storage/keys/2":
+			rw.Write([]byte(`{"id": 2, "label": "limited", "limited": true, "bucket_access": [{"cluster": "us-east-1", "bucket_name": "logs", "permissions": "read_only"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/object-storage/clusters":
+			rw.Write([]byte(`{"data": [{"id": "us-east-1", "region": "us-east"}], "page": 1, "pages": 1, "results": 1}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/object-storage/keys":
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &created)
+			rw.Write([]byte(`{"id": 3, "label": "limited", "limited": true, "bucket_access": [{"region": "us-east", "bucket_name": "logs", "permissions": "read_only"}]}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	key, err := client.MigrateObjectStorageKeyToRegions(context.Background(), 1, []string{"us-east"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key.ID != 1 || !key.IsRegionScoped() || len(updated.Regions) != 1 || updated.Label != "unlimited" {
+		t.Errorf("expected the key to be updated in place, got %+v (sent %+v)", key, updated)
+	}
+
+	key, err = client.MigrateObjectStorageKeyToRegions(context.Background(), 2, []string{"us-east"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key.ID != 3 || !key.IsRegionScoped() {
+		t.Errorf("expected a new region-scoped key, got %+v", key)
+	}
+
+	access := *created.BucketAccess
+	if len(access) != 1 || access[0].Region != "us-east" || access[0].Cluster != "" || access[0].Permissions != "read_only" {
+		t.Errorf("unexpected bucket access: %+v", access)
+	}
+
+	if _, err := client.MigrateObjectStorageKeyToRegions(context.Background(), 2, []string{"eu-central"}); err == nil {
+		t.Error("expected an error for a bucket outside of the key's regions")
+	}
+}
+
+func TestObjectStorageKey_S3EndpointForRegion(t *testing.T) {
+	key := ObjectStorageKey{
+		ID: 1,
+		Regions: []ObjectStorageKeyRegion{
+			{ID: "us-east", S3Endpoint: "us-east-1.linodeobjects.com"},
+			{ID: "us-mia"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		region   string
+		expected string
+		wantErr  bool
+	}{
+		{name: "found", region: "us-east", expected: "us-east-1.linodeobjects.com"},
+		{name: "no endpoint", region: "us-mia", wantErr: true},
+		{name: "not present", region: "eu-west", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, err := key.S3EndpointForRegion(tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if endpoint != tt.expected {
+				t.Errorf("expected endpoint %q, got %q", tt.expected, endpoint)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package linodego
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+const (
+	// ObjectStorageMultipartMinPartSize is the smallest part size accepted by the S3 API,
+	// other than for the final part of an upload
+	ObjectStorageMultipartMinPartSize = 5 * 1024 * 1024
+
+	defaultMultipartPartSize    = 8 * 1024 * 1024
+	defaultMultipartConcurrency = 4
+)
+
+// ObjectStorageMultipartUploadOptions configures UploadObjectStorageObjectMultipart
+type ObjectStorageMultipartUploadOptions struct {
+	// Endpoint is the S3 endpoint URL, defaulting to the domain of the cluster reported by the API
+	Endpoint string
+
+	// Region is the region used to sign requests, defaulting to the cluster ID
+	Region string
+
+	// AccessKey and SecretKey are the credentials used to upload the object.
+	// If they are not set, a temporary key limited to the bucket is created
+	// for the upload and deleted once it has finished.
+	AccessKey string
+	SecretKey string
+
+	ContentType string
+
+	// PartSize is the size in bytes of each uploaded part, defaulting to 8 MiB
+	PartSize int64
+
+	// Concurrency is the number of parts uploaded at once, defaulting to 4
+	Concurrency int
+}
+
+type multipartUpload struct {
+	s3       *objectStorageS3Client
+	bucket   string
+	key      string
+	uploadID string
+}
+
+type multipartPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []multipartPart `xml:"Part"`
+}
+
+// UploadObjectStorageObjectMultipart uploads the contents of body to the given bucket and key
+// using an S3 multipart upload, returning the ETag of the uploaded object.
+// Parts are read from body sequentially and uploaded concurrently. If any part fails, the
+// multipart upload is aborted so that no partial object is left behind.
+func (c *Client) UploadObjectStorageObjectMultipart(
+	ctx context.Context,
+	clusterID, bucket, key string,
+	body io.Reader,
+	opts ObjectStorageMultipartUploadOptions,
+) (etag string, err error) {
+	if opts.PartSize == 0 {
+		opts.PartSize = defaultMultipartPartSize
+	}
+
+	if opts.PartSize < ObjectStorageMultipartMinPartSize {
+		return "", fmt.Errorf("part size must be at least %d bytes", ObjectStorageMultipartMinPartSize)
+	}
+
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultMultipartConcurrency
+	}
+
+	s3, cleanup, err := c.newObjectStorageS3Client(ctx, clusterID, objectStorageS3Options{
+		endpoint:  opts.Endpoint,
+		region:    opts.Region,
+		accessKey: opts.AccessKey,
+		secretKey: opts.SecretKey,
+		bucket:    bucket,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		if err = withObjectStorageS3Cleanup(err, cleanup); err != nil {
+			etag = ""
+		}
+	}()
+
+	upload := &multipartUpload{s3: s3, bucket: bucket, key: key}
+
+	if err := upload.create(ctx, opts.ContentType); err != nil {
+		return "", err
+	}
+
+	parts, err := upload.uploadParts(ctx, body, opts.PartSize, opts.Concurrency)
+	if err != nil {
+		if abortErr := upload.abort(context.Background()); abortErr != nil {
+			return "", fmt.Errorf("%w (failed to abort upload: %s)", err, abortErr)
+		}
+
+		return "", err
+	}
+
+	return upload.complete(ctx, parts)
+}
+
+func (u *multipartUpload) create(ctx context.Context, contentType string) error {
+	header := http.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	resp, err := u.do(ctx, http.MethodPost, url.Values{"uploads": {""}}, header, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+
+	if err := xml.Unmarshal(resp, &result); err != nil || result.UploadID == "" {
+		return fmt.Errorf("failed to create multipart upload: unexpected response %q", resp)
+	}
+
+	u.uploadID = result.UploadID
+
+	return nil
+}
+
+// uploadParts reads body in chunks of partSize and uploads them with up to
+// concurrency parts in flight, returning the uploaded parts in order.
+func (u *multipartUpload) uploadParts(ctx context.Context, body io.Reader, partSize int64, concurrency int) ([]multipartPart, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		lock     sync.Mutex
+		parts    []multipartPart
+		firstErr error
+	)
+
+	fail := func(err error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	slots := make(chan struct{}, concurrency)
+
+	for partNumber := 1; ; partNumber++ {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		buf := make([]byte, partSize)
+
+		n, err := io.ReadFull(body, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			<-slots
+			fail(fmt.Errorf("failed to read part %d: %w", partNumber, err))
+
+			break
+		}
+
+		// Every upload needs at least one part, even if body is empty
+		if n == 0 && partNumber > 1 {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+
+		go func(partNumber int, data []byte) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			etag, err := u.uploadPart(ctx, partNumber, data)
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			lock.Lock()
+			parts = append(parts, multipartPart{PartNumber: partNumber, ETag: etag})
+			lock.Unlock()
+		}(partNumber, buf[:n])
+
+		if n < len(buf) {
+			break
+		}
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].PartNumber < parts[j].PartNumber
+	})
+
+	return parts, nil
+}
+
+func (u *multipartUpload) uploadPart(ctx context.Context, partNumber int, data []byte) (string, error) {
+	query := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {u.uploadID},
+	}
+
+	var etag string
+
+	_, err := u.doWithResponse(ctx, http.MethodPut, query, http.Header{}, data, func(resp *http.Response) {
+		etag = resp.Header.Get("ETag")
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+	}
+
+	if etag == "" {
+		return "", fmt.Errorf("failed to upload part %d: no ETag returned", partNumber)
+	}
+
+	return etag, nil
+}
+
+func (u *multipartUpload) complete(ctx context.Context, parts []multipartPart) (string, error) {
+	body, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return "", err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/xml")
+
+	resp, err := u.do(ctx, http.MethodPost, url.Values{"uploadId": {u.uploadID}}, header, body)
+	if err != nil {
+		return "", fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	// S3 may report a failure to complete the upload with a successful status code
+	var s3Err s3ErrorResponse
+	if xml.Unmarshal(resp, &s3Err) == nil {
+		return "", fmt.Errorf("failed to complete multipart upload: %s: %s", s3Err.Code, s3Err.Message)
+	}
+
+	var result struct {
+		ETag string `xml:"ETag"`
+	}
+
+	if err := xml.Unmarshal(resp, &result); err != nil {
+		return "", fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	return result.ETag, nil
+}
+
+func (u *multipartUpload) abort(ctx context.Context) error {
+	_, err := u.do(ctx, http.MethodDelete, url.Values{"uploadId": {u.uploadID}}, http.Header{}, nil)
+	return err
+}
+
+func (u *multipartUpload) do(ctx context.Context, method string, query url.Values, header http.Header, body []byte) ([]byte, error) {
+	return u.doWithResponse(ctx, method, query, header, body, nil)
+}
+
+func (u *multipartUpload) doWithResponse(
+	ctx context.Context,
+	method string,
+	query url.Values,
+	header http.Header,
+	body []byte,
+	onResponse func(*http.Response),
+) ([]byte, error) {
+	return u.s3.do(ctx, method, u.bucket+"/"+u.key, query, header, body, onResponse)
+}
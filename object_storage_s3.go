@@ -0,0 +1,424 @@
+package linodego
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// objectStorageS3Client makes signed requests against the S3-compatible
+// Object Storage API for settings that are not exposed by the Linode API.
+type objectStorageS3Client struct {
+	httpClient *http.Client
+	endpoint   string
+	region     string
+	accessKey  string
+	secretKey  string
+}
+
+// ObjectStorageS3Options configures how requests are made to the S3 API of a bucket,
+// for the Object Storage settings that are not exposed by the Linode API.
+type ObjectStorageS3Options struct {
+	// Endpoint is the S3 endpoint URL. It defaults to the domain of the cluster or the
+	// S3 endpoint of the region reported by the API.
+	Endpoint string
+
+	// Region is the region used to sign requests, defaulting to the cluster or region ID
+	Region string
+
+	// AccessKey and SecretKey are the credentials used to make the requests.
+	// If they are not set, a temporary key limited to the bucket is created
+	// and deleted once the requests have finished.
+	AccessKey string
+	SecretKey string
+}
+
+type objectStorageS3Options struct {
+	endpoint  string
+	region    string
+	accessKey string
+	secretKey string
+
+	// bucket is the bucket the temporary key created when no credentials are given is limited to
+	bucket string
+}
+
+func (o ObjectStorageS3Options) forBucket(bucket string) objectStorageS3Options {
+	return objectStorageS3Options{
+		endpoint:  o.Endpoint,
+		region:    o.Region,
+		accessKey: o.AccessKey,
+		secretKey: o.SecretKey,
+		bucket:    bucket,
+	}
+}
+
+type s3ErrorResponse struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+// s3Error is an error response of the S3 API. It unwraps to an Error with the HTTP status.
+type s3Error struct {
+	err  Error
+	code string
+}
+
+func (e *s3Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *s3Error) Unwrap() error {
+	return e.err
+}
+
+// isS3ErrorCode returns whether err is an error response of the S3 API with the given code, e.g. NoSuchBucket
+func isS3ErrorCode(err error, code string) bool {
+	var s3Err *s3Error
+	return errors.As(err, &s3Err) && s3Err.code == code
+}
+
+// newObjectStorageS3Client returns a client for the S3 API of the given cluster or region.
+// If no credentials are given, a temporary Object Storage key with read_write access to
+// opts.bucket is created. The returned cleanup func deletes it and must be called if no
+// error is returned, see withObjectStorageS3Cleanup.
+func (c *Client) newObjectStorageS3Client(
+	ctx context.Context,
+	clusterOrRegionID string,
+	opts objectStorageS3Options,
+) (*objectStorageS3Client, func() error, error) {
+	cleanup := func() error { return nil }
+
+	if opts.region == "" {
+		opts.region = clusterOrRegionID
+	}
+
+	createKey := opts.accessKey == "" || opts.secretKey == ""
+
+	var cluster *ObjectStorageCluster
+
+	if opts.endpoint == "" || createKey {
+		var err error
+		if cluster, err = c.findObjectStorageCluster(ctx, clusterOrRegionID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var key *ObjectStorageKey
+
+	if createKey {
+		access := ObjectStorageKeyBucketAccess{BucketName: opts.bucket, Permissions: "read_write"}
+		createOpts := ObjectStorageKeyCreateOptions{Label: fmt.Sprintf("linodego-temp-%d", time.Now().Unix())}
+
+		if cluster != nil {
+			access.Cluster = cluster.ID
+		} else {
+			access.Region = clusterOrRegionID
+			createOpts.Regions = []string{clusterOrRegionID}
+		}
+
+		createOpts.BucketAccess = &[]ObjectStorageKeyBucketAccess{access}
+
+		var err error
+		if key, err = c.CreateObjectStorageKey(ctx, createOpts); err != nil {
+			return nil, nil, fmt.Errorf("failed to create temporary object storage key: %w", err)
+		}
+
+		keyID := key.ID
+		cleanup = func() error {
+			if err := c.DeleteObjectStorageKey(context.Background(), keyID); err != nil {
+				return fmt.Errorf("failed to delete temporary object storage key %d: %w", keyID, err)
+			}
+
+			return nil
+		}
+
+		opts.accessKey = key.AccessKey
+		opts.secretKey = key.SecretKey
+	}
+
+	if opts.endpoint == "" {
+		endpoint, err := c.objectStorageS3Endpoint(ctx, clusterOrRegionID, cluster, key, opts.accessKey)
+		if err != nil {
+			return nil, nil, withObjectStorageS3Cleanup(err, cleanup)
+		}
+
+		opts.endpoint = endpoint
+	}
+
+	return &objectStorageS3Client{
+		httpClient: c.resty.GetClient(),
+		endpoint:   strings.TrimSuffix(opts.endpoint, "/"),
+		region:     opts.region,
+		accessKey:  opts.accessKey,
+		secretKey:  opts.secretKey,
+	}, cleanup, nil
+}
+
+// withObjectStorageS3Cleanup calls cleanup and returns err, or the error of cleanup if err is nil
+func withObjectStorageS3Cleanup(err error, cleanup func() error) error {
+	cleanupErr := cleanup()
+
+	switch {
+	case cleanupErr == nil:
+		return err
+	case err == nil:
+		return cleanupErr
+	default:
+		return fmt.Errorf("%w (%s)", err, cleanupErr)
+	}
+}
+
+// findObjectStorageCluster returns the cluster with the given ID, or nil if id is not a cluster
+func (c *Client) findObjectStorageCluster(ctx context.Context, id string) (*ObjectStorageCluster, error) {
+	clusters, err := c.ListObjectStorageClusters(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list object storage clusters: %w", err)
+	}
+
+	for i := range clusters {
+		if clusters[i].ID == id {
+			return &clusters[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// objectStorageS3Endpoint returns the S3 endpoint URL of the given cluster or region: the domain of
+// the cluster, or the S3 endpoint of the region for key. If key is nil, the key with accessKey is used.
+func (c *Client) objectStorageS3Endpoint(
+	ctx context.Context,
+	clusterOrRegionID string,
+	cluster *ObjectStorageCluster,
+	key *ObjectStorageKey,
+	accessKey string,
+) (string, error) {
+	if cluster != nil {
+		return s3EndpointURL(cluster.Domain), nil
+	}
+
+	if key == nil {
+		keys, err := c.ListObjectStorageKeys(ctx, nil)
+		if err != nil {
+			return "", fmt.Errorf("failed to find the S3 endpoint of region %s, set an endpoint: %w", clusterOrRegionID, err)
+		}
+
+		for i := range keys {
+			if keys[i].AccessKey == accessKey {
+				key = &keys[i]
+				break
+			}
+		}
+
+		if key == nil {
+			return "", fmt.Errorf("failed to find the S3 endpoint of region %s, set an endpoint: object storage key %s was not found", clusterOrRegionID, accessKey)
+		}
+	}
+
+	endpoint, err := key.S3EndpointForRegion(clusterOrRegionID)
+	if err != nil {
+		return "", err
+	}
+
+	return s3EndpointURL(endpoint), nil
+}
+
+// s3EndpointURL returns the URL of an S3 endpoint hostname as reported by the API
+func s3EndpointURL(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+
+	return "https://" + endpoint
+}
+
+// do makes a signed request against the given bucket or object path and returns the response body.
+// If onResponse is set, it is called with the successful response before the body is read.
+func (s *objectStorageS3Client) do(
+	ctx context.Context,
+	method, path string,
+	query url.Values,
+	header http.Header,
+	body []byte,
+	onResponse func(*http.Response),
+) ([]byte, error) {
+	endpoint, err := url.Parse(s.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %s: %w", s.endpoint, err)
+	}
+
+	objectPath := fmt.Sprintf("%s/%s", strings.TrimSuffix(endpoint.Path, "/"), path)
+	endpoint.Path = objectPath
+	endpoint.RawPath = s3URIEncode(objectPath, false)
+	endpoint.RawQuery = s3CanonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	signS3Request(req, body, s.accessKey, s.secretKey, s.region, time.Now())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var s3Err s3ErrorResponse
+		if xml.Unmarshal(respBody, &s3Err) == nil {
+			return nil, &s3Error{
+				err:  Error{Code: resp.StatusCode, Message: fmt.Sprintf("%s: %s", s3Err.Code, s3Err.Message), Response: resp},
+				code: s3Err.Code,
+			}
+		}
+
+		return nil, Error{Code: resp.StatusCode, Message: http.StatusText(resp.StatusCode), Response: resp}
+	}
+
+	if onResponse != nil {
+		onResponse(resp)
+	}
+
+	return respBody, nil
+}
+
+// signS3Request signs req using AWS Signature Version 4. The host and all
+// headers already set on req are included in the signature.
+func signS3Request(req *http.Request, body []byte, accessKey, secretKey, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+secretKey), date)
+	for _, part := range []string{region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signedHeaders, signature,
+	))
+}
+
+// s3CanonicalQuery encodes query in the sorted form required by Signature Version 4.
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var pairs []string
+
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+
+		for _, v := range values {
+			pairs = append(pairs, s3URIEncode(k, true)+"="+s3URIEncode(v, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// s3URIEncode percent-encodes every byte of s outside of the RFC 3986 unreserved set.
+// Slashes are only encoded if encodeSlash is true.
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		switch {
+		case (ch >= 'A' && ch <= 'Z') || (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9'),
+			ch == '-', ch == '_', ch == '.', ch == '~':
+			b.WriteByte(ch)
+		case ch == '/' && !encodeSlash:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+
+	return h.Sum(nil)
+}
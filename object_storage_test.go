@@ -0,0 +1,29 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_GetObjectStorageUsageByRegion(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/object-storage/buckets/us-east-1": {http.StatusOK, `{"data": [
+			{"label": "logs", "cluster": "us-east-1", "objects": 10, "size": 2048},
+			{"label": "backups", "cluster": "us-east-1", "objects": 5, "size": 1024}
+		], "page": 1, "pages": 1, "results": 2}`},
+	})
+	defer ts.Close()
+
+	usage, err := client.GetObjectStorageUsageByRegion(context.Background(), "us-east-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &ObjectStorageUsage{Region: "us-east-1", Buckets: 2, Objects: 15, Size: 3072}
+	if diff := cmp.Diff(expected, usage); diff != "" {
+		t.Errorf("unexpected usage: %s", diff)
+	}
+}
@@ -33,6 +33,9 @@ type ListOptions struct {
 	// calls. QueryParams should be an instance of a struct containing fields with
 	// the `query` tag.
 	QueryParams any
+
+	// sortOrders are set by SetOrder and ThenBy
+	sortOrders []listSortOrder
 }
 
 // NewListOptions simplified construction of ListOptions using only
@@ -53,6 +56,11 @@ func (l ListOptions) Hash() (string, error) {
 
 	h.Write(data)
 
+	// Client-side orders change the results, so they're part of the hash
+	for _, order := range l.sortOrders {
+		h.Write([]byte(order.field + ":" + order.order))
+	}
+
 	return fmt.Sprintf("%x", h.Sum(nil)), nil
 }
 
@@ -90,18 +98,18 @@ type PagedResponse interface {
 	castResult(*resty.Request, string) (int, int, error)
 }
 
-// listHelper abstracts fetching and pagination for GET endpoints that
-// do not require any Ids (top level endpoints).
+// listHelper abstracts fetching and pagination for top level GET endpoints.
+// Nested endpoints scoped under a parent resource use listPaginated instead.
 // When opts (or opts.Page) is nil, all pages will be fetched and
 // returned in a single (endpoint-specific)PagedResponse
 // opts.results and opts.pages will be updated from the API response
-func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *ListOptions, ids ...any) error {
+func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *ListOptions) error {
 	req := c.R(ctx)
 	if err := applyListOptionsToRequest(opts, req); err != nil {
 		return err
 	}
 
-	pages, results, err := pager.castResult(req, pager.endpoint(ids...))
+	pages, results, err := pager.castResult(req, pager.endpoint())
 	if err != nil {
 		return err
 	}
@@ -114,17 +122,83 @@ func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *List
 	if opts.Page == 0 {
 		for page := 2; page <= pages; page++ {
 			opts.Page = page
-			if err := c.listHelper(ctx, pager, opts, ids...); err != nil {
+			if err := c.listHelper(ctx, pager, opts); err != nil {
 				return err
 			}
 		}
 	}
 
+	sortPagedResponse(opts, pager)
+
 	opts.Results = results
 	opts.Pages = pages
 	return nil
 }
 
+// paginatedResponse is the generic shape of a single page returned
+// by a List endpoint.
+type paginatedResponse[T any] struct {
+	Page    int `json:"page"`
+	Pages   int `json:"pages"`
+	Results int `json:"results"`
+	Data    []T `json:"data"`
+}
+
+// listPaginated fetches the list at the given endpoint and decodes each item as T.
+// This is used for nested endpoints that are scoped under a parent resource
+// (e.g. linode/instances/{id}/disks) so they can share a single page loop.
+// When opts (or opts.Page) is nil or zero, all pages will be fetched and
+// returned in a single slice. opts.Results and opts.Pages will be updated
+// from the API response.
+func listPaginated[T any](ctx context.Context, c *Client, endpoint string, opts *ListOptions) ([]T, error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	if opts.PageOptions == nil {
+		opts.PageOptions = &PageOptions{}
+	}
+
+	fetchAll := opts.Page == 0
+
+	page := opts.Page
+	if fetchAll {
+		page = 1
+	}
+
+	var result []T
+
+	for {
+		req := c.R(ctx).SetResult(&paginatedResponse[T]{})
+		if err := applyListOptionsToRequest(opts, req); err != nil {
+			return nil, err
+		}
+
+		req.SetQueryParam("page", strconv.Itoa(page))
+
+		r, err := coupleAPIErrors(req.Get(endpoint))
+		if err != nil {
+			return nil, err
+		}
+
+		response := r.Result().(*paginatedResponse[T])
+		result = append(result, response.Data...)
+
+		opts.Pages = response.Pages
+		opts.Results = response.Results
+
+		if !fetchAll || page >= response.Pages {
+			break
+		}
+
+		page++
+	}
+
+	sortListResults(opts, result)
+
+	return result, nil
+}
+
 // flattenQueryStruct flattens a structure into a Resty-compatible query param map.
 // Fields are mapped using the `query` struct tag.
 func flattenQueryStruct(val any) (map[string]string, error) {
@@ -196,3 +270,59 @@ func queryFieldToString(value reflect.Value) (string, error) {
 		return "", fmt.Errorf("unsupported query param type: %s", value.Type().Name())
 	}
 }
+
+// ListWithMeta fetches a single page using the given List function and returns it
+// along with the pagination metadata reported by the API. The first page is fetched
+// if opts does not specify a page. opts is not modified.
+func ListWithMeta[T any](
+	ctx context.Context,
+	list func(context.Context, *ListOptions) ([]T, error),
+	opts *ListOptions,
+) ([]T, PageOptions, error) {
+	pageOpts := ListOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	page := 1
+	if pageOpts.PageOptions != nil && pageOpts.Page > 0 {
+		page = pageOpts.Page
+	}
+
+	pageOpts.PageOptions = &PageOptions{Page: page}
+
+	data, err := list(ctx, &pageOpts)
+	if err != nil {
+		return nil, PageOptions{}, err
+	}
+
+	return data, *pageOpts.PageOptions, nil
+}
+
+// LastPage returns the number of the last page that the given List function returns
+// for the page size and filter of opts, by fetching only the first page.
+// Setting opts.Page to the result allows navigating directly to the newest records.
+func LastPage[T any](
+	ctx context.Context,
+	list func(context.Context, *ListOptions) ([]T, error),
+	opts *ListOptions,
+) (int, error) {
+	probeOpts := ListOptions{}
+	if opts != nil {
+		probeOpts = *opts
+	}
+
+	probeOpts.PageOptions = &PageOptions{Page: 1}
+
+	_, meta, err := ListWithMeta(ctx, list, &probeOpts)
+	if err != nil {
+		return 0, err
+	}
+
+	// An empty list still has a single (empty) page
+	if meta.Pages < 1 {
+		return 1, nil
+	}
+
+	return meta.Pages, nil
+}
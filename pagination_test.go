@@ -1,9 +1,14 @@
 package linodego
 
 import (
-	"github.com/google/go-cmp/cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestFlattenQueryStruct(t *testing.T) {
@@ -46,3 +51,143 @@ func TestFlattenQueryStruct(t *testing.T) {
 		t.Fatalf("diff in result: %v", cmp.Diff(result, expectedOutput))
 	}
 }
+
+func TestListPaginated(t *testing.T) {
+	route := "/v4/linode/instances/123/disks"
+	requestedPages := []string{}
+
+	h := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != route {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		page := r.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+
+		rw.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(rw, `{"data": [{"id": %s}], "page": %s, "pages": 3, "results": 3}`, page, page)
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	opts := &ListOptions{}
+
+	disks, err := listPaginated[InstanceDisk](context.Background(), &client, "linode/instances/123/disks", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(disks) != 3 || disks[0].ID != 1 || disks[2].ID != 3 {
+		t.Fatalf("unexpected disks: %v", disks)
+	}
+
+	if !reflect.DeepEqual(requestedPages, []string{"1", "2", "3"}) {
+		t.Fatalf("unexpected pages requested: %v", requestedPages)
+	}
+
+	if opts.Pages != 3 || opts.Results != 3 || opts.Page != 0 {
+		t.Fatalf("unexpected page options: %#v", opts.PageOptions)
+	}
+
+	// A specific page should only fetch that page
+	requestedPages = []string{}
+
+	disks, err = listPaginated[InstanceDisk](context.Background(), &client, "linode/instances/123/disks", NewListOptions(2, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(disks) != 1 || disks[0].ID != 2 {
+		t.Fatalf("unexpected disks: %v", disks)
+	}
+
+	if !reflect.DeepEqual(requestedPages, []string{"2"}) {
+		t.Fatalf("unexpected pages requested: %v", requestedPages)
+	}
+}
+
+func TestLastPage(t *testing.T) {
+	var pageSizes []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		pageSizes = append(pageSizes, r.URL.Query().Get("page_size"))
+		rw.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(rw, `{"data": [{"id": 1}], "page": %s, "pages": 4, "results": 100}`, r.URL.Query().Get("page"))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	opts := &ListOptions{PageSize: 25}
+
+	last, err := LastPage(context.Background(), client.ListInstances, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if last != 4 {
+		t.Errorf("expected last page to be 4 but got %d", last)
+	}
+
+	if diff := cmp.Diff([]string{"25"}, pageSizes); diff != "" {
+		t.Errorf("expected a single probe request with the caller's page size: %s", diff)
+	}
+
+	if opts.PageOptions != nil {
+		t.Error("expected opts not to be modified")
+	}
+
+	opts.PageOptions = &PageOptions{Page: last}
+
+	_, meta, err := ListWithMeta(context.Background(), client.ListInstances, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if meta.Page != 4 || meta.Results != 100 {
+		t.Errorf("unexpected page metadata: %+v", meta)
+	}
+}
+
+func TestLastPage_cached(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [{"id": "g6-nanode-1"}], "page": 1, "pages": 3, "results": 75}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	for i := 0; i < 2; i++ {
+		last, err := LastPage(context.Background(), client.ListTypes, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if last != 3 {
+			t.Errorf("call %d: expected last page to be 3 but got %d", i+1, last)
+		}
+
+		_, meta, err := ListWithMeta(context.Background(), client.ListTypes, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.Pages != 3 || meta.Results != 75 {
+			t.Errorf("call %d: unexpected page metadata: %+v", i+1, meta)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the page to be cached, got %d requests", requests)
+	}
+}
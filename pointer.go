@@ -0,0 +1,7 @@
+package linodego
+
+// Pointer returns a pointer to a copy of value, for setting the optional
+// pointer fields of option structs, e.g. opts.Restricted = linodego.Pointer(true)
+func Pointer[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,23 @@
+package linodego
+
+import (
+	"testing"
+)
+
+func TestPointer(t *testing.T) {
+	value := 1
+
+	ptr := Pointer(value)
+	if *ptr != 1 {
+		t.Errorf("expected 1 but got %d", *ptr)
+	}
+
+	value = 2
+	if *ptr != 1 {
+		t.Error("expected the pointer to reference a copy of the value")
+	}
+
+	if opts := (UserUpdateOptions{Restricted: Pointer(true)}); !*opts.Restricted {
+		t.Error("expected Pointer to work with untyped constants")
+	}
+}
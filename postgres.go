@@ -177,31 +177,10 @@ type PostgresDatabaseBackupsPagedResponse struct {
 	Data []PostgresDatabaseBackup `json:"data"`
 }
 
-func (PostgresDatabaseBackupsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(int)
-	return fmt.Sprintf("databases/postgresql/instances/%d/backups", id)
-}
-
-func (resp *PostgresDatabaseBackupsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(PostgresDatabaseBackupsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*PostgresDatabaseBackupsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListPostgresDatabaseBackups lists all Postgres Database Backups associated with the given Postgres Database
 func (c *Client) ListPostgresDatabaseBackups(ctx context.Context, databaseID int, opts *ListOptions) ([]PostgresDatabaseBackup, error) {
-	response := PostgresDatabaseBackupsPagedResponse{}
-
-	err := c.listHelper(ctx, &response, opts, databaseID)
-	if err != nil {
-		return nil, err
-	}
-
-	return response.Data, nil
+	e := fmt.Sprintf("databases/postgresql/instances/%d/backups", databaseID)
+	return listPaginated[PostgresDatabaseBackup](ctx, c, e, opts)
 }
 
 // GetPostgresDatabase returns a single Postgres Database matching the id
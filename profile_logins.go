@@ -12,7 +12,7 @@ import (
 
 // Profile represents a Profile object
 type ProfileLogin struct {
-	Datetime   *time.Time `json:"datetime"`
+	Datetime   *time.Time `json:"-"`
 	ID         int        `json:"id"`
 	IP         string     `json:"ip"`
 	Restricted bool       `json:"restricted"`
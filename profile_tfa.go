@@ -10,7 +10,7 @@ import (
 
 // TwoFactorSecret contains fields returned by CreateTwoFactorSecret
 type TwoFactorSecret struct {
-	Expiry *time.Time `json:"expiry"`
+	Expiry *time.Time `json:"-"`
 	Secret string     `json:"secret"`
 }
 
@@ -139,7 +139,7 @@ func (c *Client) CreateToken(ctx context.Context, opts TokenCreateOptions) (*Tok
 	createOptsFixed.Label = opts.Label
 	createOptsFixed.Scopes = opts.Scopes
 	if opts.Expiry != nil {
-		iso8601Expiry := opts.Expiry.UTC().Format("2006-01-02T15:04:05")
+		iso8601Expiry := parseabletime.Format(*opts.Expiry)
 		createOptsFixed.Expiry = &iso8601Expiry
 	}
 
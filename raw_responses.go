@@ -0,0 +1,84 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// rawResponseCapture holds the body of the latest API response when capturing is enabled.
+// It is shared by copies of the Client, as the hook registered with resty outlives the
+// Client it was registered by.
+type rawResponseCapture struct {
+	lock    sync.RWMutex
+	enabled bool
+	last    json.RawMessage
+}
+
+// SetCaptureRawResponses sets whether the raw body of each API response is kept so it can be
+// retrieved with LastRawResponse. This is intended for debugging fields that are not decoded
+// as expected, without enabling the full request and response dumps of SetDebug.
+func (c *Client) SetCaptureRawResponses(enabled bool) *Client {
+	if c.rawResponses == nil {
+		if !enabled {
+			return c
+		}
+
+		capture := &rawResponseCapture{}
+		c.rawResponses = capture
+
+		c.resty.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+			capture.store(r.Body())
+			return nil
+		})
+	}
+
+	c.rawResponses.lock.Lock()
+	defer c.rawResponses.lock.Unlock()
+
+	c.rawResponses.enabled = enabled
+	c.rawResponses.last = nil
+
+	return c
+}
+
+// LastRawResponse returns the raw body of the latest API response received while
+// capturing was enabled with SetCaptureRawResponses, or nil if there is none.
+// When requests are made concurrently, the body may belong to any of them.
+func (c *Client) LastRawResponse() json.RawMessage {
+	if c.rawResponses == nil {
+		return nil
+	}
+
+	c.rawResponses.lock.RLock()
+	defer c.rawResponses.lock.RUnlock()
+
+	return c.rawResponses.last
+}
+
+func (r *rawResponseCapture) store(body []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.enabled {
+		return
+	}
+
+	r.last = append(json.RawMessage(nil), body...)
+}
+
+// GetInstanceRaw gets the instance with the provided ID, returning the raw body of the
+// response along with the decoded Instance.
+func (c *Client) GetInstanceRaw(ctx context.Context, linodeID int) (json.RawMessage, *Instance, error) {
+	e := fmt.Sprintf("linode/instances/%d", linodeID)
+	req := c.R(ctx).SetResult(&Instance{})
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return json.RawMessage(r.Body()), r.Result().(*Instance), nil
+}
@@ -0,0 +1,47 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_rawResponses(t *testing.T) {
+	body := `{"id": 123, "label": "test", "new_field": "value"}`
+
+	ts, client := createTestServer(http.MethodGet, "/v4/linode/instances/123", "application/json", body, http.StatusOK)
+	defer ts.Close()
+
+	raw, instance, err := client.GetInstanceRaw(context.Background(), 123)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(raw) != body || instance.Label != "test" {
+		t.Errorf("unexpected response: %s %+v", raw, instance)
+	}
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if last := client.LastRawResponse(); last != nil {
+		t.Errorf("expected no response to be captured, got %s", last)
+	}
+
+	client.SetCaptureRawResponses(true)
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	if last := client.LastRawResponse(); string(last) != body {
+		t.Errorf("expected the response to be captured, got %s", last)
+	}
+
+	client.SetCaptureRawResponses(false)
+
+	if last := client.LastRawResponse(); last != nil {
+		t.Errorf("expected the captured response to be cleared, got %s", last)
+	}
+}
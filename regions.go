@@ -12,6 +12,18 @@ import (
 // `status` field may update for database outages.
 var cacheExpiryTime = time.Minute
 
+// Region capabilities checked before creating resources that depend on them
+const (
+	// RegionCapabilityMetadata is the capability reported by Regions that support the Metadata service
+	RegionCapabilityMetadata = "Metadata"
+
+	// RegionCapabilityDiskEncryption is the capability reported by Regions that support disk encryption
+	RegionCapabilityDiskEncryption = "Disk Encryption"
+
+	// RegionCapabilityBlockStorageEncryption is the capability reported by Regions that support encrypted Volumes
+	RegionCapabilityBlockStorageEncryption = "Block Storage Encryption"
+)
+
 // Region represents a linode region object
 type Region struct {
 	ID           string          `json:"id"`
@@ -22,6 +34,17 @@ type Region struct {
 	Label        string          `json:"label"`
 }
 
+// HasCapability returns true if the Region reports the given capability
+func (r Region) HasCapability(capability string) bool {
+	for _, c := range r.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RegionResolvers contains the DNS resolvers of a region
 type RegionResolvers struct {
 	IPv4 string `json:"ipv4"`
@@ -58,7 +81,7 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedListResponse(endpoint, opts); result != nil {
 		return result.([]Region), nil
 	}
 
@@ -67,7 +90,7 @@ func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region,
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response.Data, &cacheExpiryTime)
+	c.addCachedListResponse(endpoint, response.Data, opts, &cacheExpiryTime)
 
 	return response.Data, nil
 }
@@ -91,3 +114,41 @@ func (c *Client) GetRegion(ctx context.Context, regionID string) (*Region, error
 
 	return r.Result().(*Region), nil
 }
+
+// ValidateRegion checks that the given region ID exists, using the cached region list.
+// If it does not, the returned error suggests similarly named regions.
+func (c *Client) ValidateRegion(ctx context.Context, regionID string) error {
+	regions, err := c.ListRegions(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list regions: %w", err)
+	}
+
+	ids := make([]string, len(regions))
+	for i, region := range regions {
+		if region.ID == regionID {
+			return nil
+		}
+
+		ids[i] = region.ID
+	}
+
+	if suggestion := formatSuggestions(suggestValues(regionID, ids)); suggestion != "" {
+		return fmt.Errorf("unknown region %q, %s", regionID, suggestion)
+	}
+
+	return fmt.Errorf("unknown region %q", regionID)
+}
+
+// validateRegionCapability returns an error if the Region does not report the given capability.
+func (c *Client) validateRegionCapability(ctx context.Context, regionID, capability string) error {
+	region, err := c.GetRegion(ctx, regionID)
+	if err != nil {
+		return err
+	}
+
+	if !region.HasCapability(capability) {
+		return fmt.Errorf("region %s does not support %s", regionID, capability)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package linodego
+
+import (
+	"context"
+	"fmt"
+)
+
+// Region capabilities required by the Linode Type classes that are not offered in every Region
+const (
+	// RegionCapabilityLinodes is the capability reported by Regions that support Linodes
+	RegionCapabilityLinodes = "Linodes"
+
+	// RegionCapabilityGPU is the capability reported by Regions that support GPU Linodes
+	RegionCapabilityGPU = "GPU Linodes"
+
+	// RegionCapabilityPremium is the capability reported by Regions that support Premium plans
+	RegionCapabilityPremium = "Premium Plans"
+)
+
+// RegionAvailability represents a Linode Type's availability within a region.
+type RegionAvailability struct {
+	Region    string `json:"region"`
+	Plan      string `json:"plan"`
+	Available bool   `json:"available"`
+}
+
+// ListRegionsAvailability lists the availability of Linode Types across all Regions.
+func (c *Client) ListRegionsAvailability(ctx context.Context, opts *ListOptions) ([]RegionAvailability, error) {
+	return listPaginated[RegionAvailability](ctx, c, "regions/availability", opts)
+}
+
+// GetRegionAvailability gets the availability of Linode Types within the Region with the provided ID.
+func (c *Client) GetRegionAvailability(ctx context.Context, regionID string) ([]RegionAvailability, error) {
+	e := fmt.Sprintf("regions/%s/availability", regionID)
+	req := c.R(ctx).SetResult(&[]RegionAvailability{})
+
+	r, err := coupleAPIErrors(req.Get(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return *r.Result().(*[]RegionAvailability), nil
+}
+
+// RegionsForType returns the Regions where Linodes of the given type can currently be created.
+// A Region is included if it reports the capabilities required by the type's class and
+// the type is not reported as unavailable (sold out) in that Region.
+func (c *Client) RegionsForType(ctx context.Context, typeID string) ([]Region, error) {
+	linodeType, err := c.GetType(ctx, typeID)
+	if err != nil {
+		return nil, err
+	}
+
+	regions, err := c.ListRegions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	availability, err := c.ListRegionsAvailability(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	unavailable := make(map[string]bool)
+
+	for _, a := range availability {
+		if a.Plan == typeID && !a.Available {
+			unavailable[a.Region] = true
+		}
+	}
+
+	required := linodeType.Class.requiredRegionCapabilities()
+
+	result := make([]Region, 0, len(regions))
+
+	for _, region := range regions {
+		if unavailable[region.ID] || !regionHasCapabilities(region, required) {
+			continue
+		}
+
+		result = append(result, region)
+	}
+
+	return result, nil
+}
+
+func regionHasCapabilities(region Region, capabilities []string) bool {
+	for _, capability := range capabilities {
+		if !region.HasCapability(capability) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,41 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClient_RegionsForType(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/types/g1-gpu-rtx6000-1": {http.StatusOK, `{"id": "g1-gpu-rtx6000-1", "class": "gpu"}`},
+		"/v4/regions": {http.StatusOK, `{"data": [
+			{"id": "us-east", "capabilities": ["Linodes", "GPU Linodes"]},
+			{"id": "us-west", "capabilities": ["Linodes"]},
+			{"id": "eu-west", "capabilities": ["Linodes", "GPU Linodes"]},
+			{"id": "ap-south", "capabilities": ["Linodes", "GPU Linodes"]}
+		], "page": 1, "pages": 1, "results": 4}`},
+		"/v4/regions/availability": {http.StatusOK, `{"data": [
+			{"region": "eu-west", "plan": "g1-gpu-rtx6000-1", "available": false},
+			{"region": "ap-south", "plan": "g1-gpu-rtx6000-2", "available": false},
+			{"region": "us-east", "plan": "g1-gpu-rtx6000-1", "available": true}
+		], "page": 1, "pages": 1, "results": 3}`},
+	})
+	defer ts.Close()
+
+	regions, err := client.RegionsForType(context.Background(), "g1-gpu-rtx6000-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make([]string, len(regions))
+	for i, region := range regions {
+		ids[i] = region.ID
+	}
+
+	if diff := cmp.Diff([]string{"us-east", "ap-south"}, ids); diff != "" {
+		t.Errorf("unexpected regions: %s", diff)
+	}
+}
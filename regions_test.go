@@ -0,0 +1,24 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_ValidateRegion(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/regions": {http.StatusOK, `{"data": [{"id": "us-east"}, {"id": "us-west"}], "page": 1, "pages": 1, "results": 2}`},
+	})
+	defer ts.Close()
+
+	if err := client.ValidateRegion(context.Background(), "us-east"); err != nil {
+		t.Fatalf("expected us-east to be valid, got %v", err)
+	}
+
+	err := client.ValidateRegion(context.Background(), "us-east-1")
+	if err == nil || !strings.Contains(err.Error(), `did you mean "us-east"?`) {
+		t.Fatalf("expected a suggestion for us-east-1, got %v", err)
+	}
+}
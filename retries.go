@@ -1,10 +1,12 @@
 package linodego
 
 import (
+	"context"
 	"errors"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -12,6 +14,8 @@ import (
 )
 
 const (
+	defaultRetryCount = 1000
+
 	retryAfterHeaderName      = "Retry-After"
 	maintenanceModeHeaderName = "X-Maintenance-Mode"
 )
@@ -25,15 +29,30 @@ type RetryAfter resty.RetryAfterFunc
 // Configures resty to
 // lock until enough time has passed to retry the request as determined by the Retry-After response header.
 // If the Retry-After header is not set, we fall back to value of SetPollDelay.
+// A retry count set with SetRetryCount is kept, so that a count of 0 stays authoritative.
 func configureRetries(c *Client) {
+	count := defaultRetryCount
+	if c.retrySettings.countSet {
+		count = c.retrySettings.count
+	}
+
 	c.resty.
-		SetRetryCount(1000).
+		SetRetryCount(count).
 		AddRetryCondition(checkRetryConditionals(c)).
 		SetRetryAfter(respectRetryAfter)
 }
 
 func checkRetryConditionals(c *Client) func(*resty.Response, error) bool {
 	return func(r *resty.Response, err error) bool {
+		// Requests rejected before being sent have no response to inspect
+		if r == nil {
+			return false
+		}
+
+		if !retryAllowedByContext(r) {
+			return false
+		}
+
 		for _, retryConditional := range c.retryConditionals {
 			retry := retryConditional(r, err)
 			if retry {
@@ -45,13 +64,82 @@ func checkRetryConditionals(c *Client) func(*resty.Response, error) bool {
 	}
 }
 
-// SetLinodeBusyRetry configures resty to retry specifically on "Linode busy." errors
-// The retry wait time is configured in SetPollDelay
+type retryCountContextKey struct{}
+
+// WithRetryCount returns a copy of ctx that limits requests made with it to count retries,
+// overriding the Client's retry count for those requests only. A count of 0 attempts each
+// request once. The override can only lower the number of retries: a Client whose retry
+// count is 0 never retries, and a count above the Client's is limited to the Client's.
+func WithRetryCount(ctx context.Context, count int) context.Context {
+	if count < 0 {
+		count = 0
+	}
+
+	return context.WithValue(ctx, retryCountContextKey{}, count)
+}
+
+// retryAllowedByContext returns false if the request of r has used up the retries
+// allowed by a count set on its context with WithRetryCount
+func retryAllowedByContext(r *resty.Response) bool {
+	if r.Request == nil {
+		return true
+	}
+
+	count, ok := r.Request.Context().Value(retryCountContextKey{}).(int)
+
+	// Attempt counts the attempts made so far, including the first
+	return !ok || r.Request.Attempt <= count
+}
+
+// retrySettings holds the retry options of a Client. It is shared by copies of the
+// Client, as the retry conditions registered with resty outlive the Client they were
+// registered by.
+type retrySettings struct {
+	linodeBusyDisabled bool
+
+	// count is the retry count set with SetRetryCount, if countSet
+	count    int
+	countSet bool
+}
+
+// SetLinodeBusyRetry sets whether requests failing because the Linode is busy with
+// another operation are retried, which they are by default. See IsLinodeBusy.
+func (c *Client) SetLinodeBusyRetry(enabled bool) *Client {
+	c.retrySettings.linodeBusyDisabled = !enabled
+	return c
+}
+
+// IsLinodeBusy returns true if err indicates that the request was rejected because
+// the Linode is busy with another operation, e.g. a boot or resize that is still
+// finishing. The request can be retried once the operation has completed.
+func IsLinodeBusy(err error) bool {
+	linodeErr, ok := asError(err)
+	return ok && linodeErr.Code == http.StatusBadRequest && isLinodeBusyReason(linodeErr.Message)
+}
+
+func isLinodeBusyReason(reason string) bool {
+	return strings.Contains(strings.ToLower(reason), "linode busy")
+}
+
+// linodeBusyRetryCondition retries "Linode busy." errors, which are 400s.
+// The retry wait time is configured in SetRetryWaitTime
 func linodeBusyRetryCondition(r *resty.Response, _ error) bool {
+	if r.StatusCode() != http.StatusBadRequest {
+		return false
+	}
+
 	apiError, ok := r.Error().(*APIError)
-	linodeBusy := ok && apiError.Error() == "Linode busy."
-	retry := r.StatusCode() == http.StatusBadRequest && linodeBusy
-	return retry
+	if !ok {
+		return false
+	}
+
+	for _, reason := range apiError.Errors {
+		if isLinodeBusyReason(reason.Reason) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func tooManyRequestsRetryCondition(r *resty.Response, _ error) bool {
@@ -1,7 +1,9 @@
 package linodego
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -38,6 +40,63 @@ func TestLinodeBusyRetryCondition(t *testing.T) {
 	}
 }
 
+func TestLinodeBusyRetryCondition_reasons(t *testing.T) {
+	request := resty.Request{}
+	request.SetError(&APIError{Errors: []APIErrorReason{{Reason: "Linode busy; please try again later."}}})
+
+	response := resty.Response{
+		Request:     &request,
+		RawResponse: &http.Response{StatusCode: http.StatusBadRequest},
+	}
+
+	if !linodeBusyRetryCondition(&response, nil) {
+		t.Error("expected busy reasons with additional text to be retried")
+	}
+
+	if !IsLinodeBusy(&Error{Code: http.StatusBadRequest, Message: "Linode busy."}) {
+		t.Error("expected error to be classified as Linode busy")
+	}
+
+	if IsLinodeBusy(&Error{Code: http.StatusConflict, Message: "Linode busy."}) {
+		t.Error("expected only 400s to be classified as Linode busy")
+	}
+}
+
+func TestClient_SetLinodeBusyRetry(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Add("Content-Type", "application/json")
+
+		if requests == 1 {
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"errors": [{"reason": "Linode busy."}]}`))
+
+			return
+		}
+
+		rw.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+
+	if err := client.BootInstance(context.Background(), 123, 0); err != nil || requests != 2 {
+		t.Fatalf("expected the busy request to be retried, got %d requests and %v", requests, err)
+	}
+
+	requests = 0
+	client.SetLinodeBusyRetry(false)
+
+	if err := client.BootInstance(context.Background(), 123, 0); !IsLinodeBusy(err) || requests != 1 {
+		t.Fatalf("expected the busy request to not be retried, got %d requests and %v", requests, err)
+	}
+}
+
 func TestLinodeServiceUnavailableRetryCondition(t *testing.T) {
 	request := resty.Request{}
 	rawResponse := http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{
@@ -74,3 +133,69 @@ func TestLinodeServiceMaintenanceModeRetryCondition(t *testing.T) {
 		t.Error("expected retry to be skipped due to maintenance mode header")
 	}
 }
+
+func TestClient_SetRetryCount_zero(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusRequestTimeout} {
+		requests := 0
+
+		ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			requests++
+
+			rw.Header().Add("Content-Type", "application/json")
+			rw.Header().Add(retryAfterHeaderName, "0")
+			rw.WriteHeader(status)
+			rw.Write([]byte(`{"errors": [{"reason": "try again"}]}`))
+		}))
+
+		client := NewClient(nil)
+		client.SetBaseURL(ts.URL)
+		client.SetRetryWaitTime(time.Millisecond)
+		client.SetRetryCount(0)
+
+		// Re-adding the default retry conditions must not restore the retry count
+		client.SetRetries()
+
+		if _, err := client.GetInstance(context.Background(), 123); err == nil {
+			t.Errorf("%d: expected an error", status)
+		}
+
+		if requests != 1 {
+			t.Errorf("%d: expected a single attempt, got %d", status, requests)
+		}
+
+		ts.Close()
+	}
+}
+
+func TestWithRetryCount(t *testing.T) {
+	requests := 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		requests++
+
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Header().Add(retryAfterHeaderName, "0")
+		rw.WriteHeader(http.StatusTooManyRequests)
+		rw.Write([]byte(`{"errors": [{"reason": "too many requests"}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+	client.SetRetryWaitTime(time.Millisecond)
+	client.AddRetryCondition(func(r *resty.Response, _ error) bool {
+		return r.StatusCode() == http.StatusTooManyRequests
+	})
+
+	for _, count := range []int{0, 2} {
+		requests = 0
+
+		if _, err := client.GetInstance(WithRetryCount(context.Background(), count), 123); err == nil {
+			t.Errorf("%d: expected an error", count)
+		}
+
+		if requests != count+1 {
+			t.Errorf("%d: expected %d attempts, got %d", count, count+1, requests)
+		}
+	}
+}
@@ -142,6 +142,30 @@ func (c *Client) ListStackscripts(ctx context.Context, opts *ListOptions) ([]Sta
 	return response.Data, nil
 }
 
+// ListMyStackscripts lists the Stackscripts owned by the current user
+func (c *Client) ListMyStackscripts(ctx context.Context, opts *ListOptions) ([]Stackscript, error) {
+	return c.listStackscriptsWithFields(ctx, opts, map[string]any{"mine": true})
+}
+
+// ListPublicStackscripts lists the Stackscripts available in the public library
+func (c *Client) ListPublicStackscripts(ctx context.Context, opts *ListOptions) ([]Stackscript, error) {
+	return c.listStackscriptsWithFields(ctx, opts, map[string]any{"is_public": true})
+}
+
+// ListStackscriptsForImage lists the Stackscripts that are compatible with the given Image
+func (c *Client) ListStackscriptsForImage(ctx context.Context, image string, opts *ListOptions) ([]Stackscript, error) {
+	return c.listStackscriptsWithFields(ctx, opts, map[string]any{"images": image})
+}
+
+func (c *Client) listStackscriptsWithFields(ctx context.Context, opts *ListOptions, fields map[string]any) ([]Stackscript, error) {
+	opts, err := withFilterFields(opts, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListStackscripts(ctx, opts)
+}
+
 // GetStackscript gets the Stackscript with the provided ID
 func (c *Client) GetStackscript(ctx context.Context, scriptID int) (*Stackscript, error) {
 	e := fmt.Sprintf("linode/stackscripts/%d", scriptID)
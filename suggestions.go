@@ -0,0 +1,231 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// maxSuggestions is the number of suggestions included in "did you mean" messages.
+const maxSuggestions = 3
+
+// suggestionCatalogs maps request fields to the catalog of values they accept.
+// When suggestions are enabled, an invalid value for one of these fields
+// will have similar valid values appended to its error.
+var suggestionCatalogs = map[string]func(context.Context, *Client) ([]string, error){
+	"region": func(ctx context.Context, c *Client) ([]string, error) {
+		return catalogIDs(ctx, c.ListRegions, func(r Region) string { return r.ID })
+	},
+	"type": func(ctx context.Context, c *Client) ([]string, error) {
+		return catalogIDs(ctx, c.ListTypes, func(t LinodeType) string { return t.ID })
+	},
+	"image": func(ctx context.Context, c *Client) ([]string, error) {
+		return catalogIDs(ctx, c.ListImages, func(i Image) string { return i.ID })
+	},
+	"kernel": func(ctx context.Context, c *Client) ([]string, error) {
+		return catalogIDs(ctx, c.ListKernels, func(k LinodeKernel) string { return k.ID })
+	},
+	"engine": func(ctx context.Context, c *Client) ([]string, error) {
+		return catalogIDs(ctx, c.ListDatabaseEngines, func(e DatabaseEngine) string { return e.ID })
+	},
+	"k8s_version": func(ctx context.Context, c *Client) ([]string, error) {
+		return catalogIDs(ctx, c.ListLKEVersions, func(v LKEVersion) string { return v.ID })
+	},
+}
+
+func catalogIDs[T any](
+	ctx context.Context,
+	list func(context.Context, *ListOptions) ([]T, error),
+	id func(T) string,
+) ([]string, error) {
+	items, err := list(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = id(item)
+	}
+
+	return ids, nil
+}
+
+// SetSuggestionsEnabled sets whether "did you mean" suggestions are added to
+// API errors caused by an invalid value for an enumerable field, such as a
+// region, type, or image. Valid values are fetched from the API on demand,
+// using the client cache where available.
+func (c *Client) SetSuggestionsEnabled(enabled bool) *Client {
+	c.suggestionsEnabled = enabled
+
+	if enabled && !c.suggestionsHookAdded {
+		c.suggestionsHookAdded = true
+		c.resty.OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+			if c.suggestionsEnabled {
+				c.addSuggestions(r)
+			}
+
+			return nil
+		})
+	}
+
+	return c
+}
+
+// addSuggestions appends suggestions to the reasons of a failed response
+// for fields that have a known catalog of valid values.
+func (c *Client) addSuggestions(r *resty.Response) {
+	if r.StatusCode() != http.StatusBadRequest {
+		return
+	}
+
+	apiError, ok := r.Error().(*APIError)
+	if !ok {
+		return
+	}
+
+	values := requestBodyValues(r.Request)
+
+	for i, reason := range apiError.Errors {
+		catalog, ok := suggestionCatalogs[reason.Field]
+		if !ok {
+			continue
+		}
+
+		value, ok := values[reason.Field].(string)
+		if !ok || value == "" {
+			continue
+		}
+
+		candidates, err := catalog(r.Request.Context(), c)
+		if err != nil {
+			continue
+		}
+
+		if suggestion := formatSuggestions(suggestValues(value, candidates)); suggestion != "" {
+			apiError.Errors[i].Reason = fmt.Sprintf("%s; %s", reason.Reason, suggestion)
+		}
+	}
+}
+
+// requestBodyValues decodes the top level fields of a JSON request body.
+func requestBodyValues(req *resty.Request) map[string]any {
+	var body []byte
+
+	switch b := req.Body.(type) {
+	case string:
+		body = []byte(b)
+	case []byte:
+		body = b
+	default:
+		return nil
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal(body, &values); err != nil {
+		return nil
+	}
+
+	return values
+}
+
+// suggestValues returns up to maxSuggestions candidates that closely match value,
+// ordered from the closest match.
+func suggestValues(value string, candidates []string) []string {
+	value = strings.ToLower(value)
+
+	// Allow roughly one edit for every three characters, with a floor of two edits
+	maxDistance := len(value) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type match struct {
+		candidate string
+		distance  int
+	}
+
+	var matches []match
+
+	for _, candidate := range candidates {
+		distance := levenshtein(value, strings.ToLower(candidate))
+		if distance <= maxDistance {
+			matches = append(matches, match{candidate, distance})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+
+	result := make([]string, len(matches))
+	for i, m := range matches {
+		result[i] = m.candidate
+	}
+
+	return result
+}
+
+// formatSuggestions formats suggestions as a "did you mean" clause,
+// or returns an empty string if there are none.
+func formatSuggestions(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+
+	return fmt.Sprintf("did you mean %s?", strings.Join(quoted, ", "))
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,81 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"us-east", "us-east", 0},
+		{"us-east-1", "us-east", 2},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+
+	for _, tt := range tests {
+		if actual := levenshtein(tt.a, tt.b); actual != tt.expected {
+			t.Errorf("expected distance between %q and %q to be %d but got %d", tt.a, tt.b, tt.expected, actual)
+		}
+	}
+}
+
+func TestSuggestValues(t *testing.T) {
+	candidates := []string{"us-east", "us-west", "us-central", "eu-west", "ap-south"}
+
+	if diff := cmp.Diff([]string{"us-east"}, suggestValues("us-east-1", candidates)); diff != "" {
+		t.Errorf("unexpected suggestions: %s", diff)
+	}
+
+	if diff := cmp.Diff([]string{"us-west"}, suggestValues("us-wset", candidates)); diff != "" {
+		t.Errorf("unexpected suggestions: %s", diff)
+	}
+
+	if suggestions := suggestValues("nowhere-at-all", candidates); len(suggestions) != 0 {
+		t.Errorf("expected no suggestions but got %v", suggestions)
+	}
+}
+
+func TestClient_SetSuggestionsEnabled(t *testing.T) {
+	h := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Add("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v4/regions":
+			rw.Write([]byte(`{"data": [{"id": "us-east"}, {"id": "eu-west"}], "page": 1, "pages": 1, "results": 2}`))
+		case "/v4/linode/instances":
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"errors": [{"field": "region", "reason": "region is not valid"}]}`))
+		default:
+			rw.WriteHeader(http.StatusNotImplemented)
+		}
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	opts := InstanceCreateOptions{Region: "us-esat", Type: "g6-nanode-1"}
+
+	_, err := client.CreateInstance(context.Background(), opts)
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Fatalf("expected an error without suggestions, got %v", err)
+	}
+
+	client.SetSuggestionsEnabled(true)
+
+	_, err = client.CreateInstance(context.Background(), opts)
+	if err == nil || !strings.Contains(err.Error(), `did you mean "us-east"?`) {
+		t.Fatalf("expected an error suggesting us-east, got %v", err)
+	}
+}
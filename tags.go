@@ -81,22 +81,6 @@ type TaggedObjectsPagedResponse struct {
 	Data []TaggedObject `json:"data"`
 }
 
-// endpoint gets the endpoint URL for Tag
-func (TaggedObjectsPagedResponse) endpoint(ids ...any) string {
-	id := ids[0].(string)
-	return fmt.Sprintf("tags/%s", id)
-}
-
-func (resp *TaggedObjectsPagedResponse) castResult(r *resty.Request, e string) (int, int, error) {
-	res, err := coupleAPIErrors(r.SetResult(TaggedObjectsPagedResponse{}).Get(e))
-	if err != nil {
-		return 0, 0, err
-	}
-	castedRes := res.Result().(*TaggedObjectsPagedResponse)
-	resp.Data = append(resp.Data, castedRes.Data...)
-	return castedRes.Pages, castedRes.Results, nil
-}
-
 // ListTags lists Tags
 func (c *Client) ListTags(ctx context.Context, opts *ListOptions) ([]Tag, error) {
 	response := TagsPagedResponse{}
@@ -147,19 +131,19 @@ func (i *TaggedObject) fixData() (*TaggedObject, error) {
 
 // ListTaggedObjects lists Tagged Objects
 func (c *Client) ListTaggedObjects(ctx context.Context, label string, opts *ListOptions) (TaggedObjectList, error) {
-	response := TaggedObjectsPagedResponse{}
 	label = url.PathEscape(label)
-	err := c.listHelper(ctx, &response, opts, label)
+	e := fmt.Sprintf("tags/%s", label)
+	response, err := listPaginated[TaggedObject](ctx, c, e, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := range response.Data {
-		if _, err := response.Data[i].fixData(); err != nil {
+	for i := range response {
+		if _, err := response[i].fixData(); err != nil {
 			return nil, err
 		}
 	}
-	return response.Data, nil
+	return response, nil
 }
 
 // SortedObjects converts a list of TaggedObjects into a Sorted Objects struct, for easier access
@@ -226,3 +210,18 @@ func (c *Client) DeleteTag(ctx context.Context, label string) error {
 	_, err := coupleAPIErrors(c.R(ctx).Delete(e))
 	return err
 }
+
+// listByTag lists the resources returned by list that have been assigned the given tag.
+func listByTag[T any](
+	ctx context.Context,
+	tag string,
+	opts *ListOptions,
+	list func(context.Context, *ListOptions) ([]T, error),
+) ([]T, error) {
+	opts, err := withFilterFields(opts, map[string]any{"tags": tag})
+	if err != nil {
+		return nil, err
+	}
+
+	return list(ctx, opts)
+}
@@ -0,0 +1,101 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_ListInstancesByTag(t *testing.T) {
+	var filter string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		filter = r.Header.Get("X-Filter")
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [{"id": 123, "tags": ["my-project"]}], "page": 1, "pages": 1, "results": 1}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	opts := NewListOptions(0, `{"region": "us-east"}`)
+
+	instances, err := client.ListInstancesByTag(context.Background(), "my-project", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(instances) != 1 || instances[0].ID != 123 {
+		t.Errorf("unexpected instances returned: %v", instances)
+	}
+
+	if expected := `{"region":"us-east","tags":"my-project"}`; filter != expected {
+		t.Errorf("expected filter %s but got %s", expected, filter)
+	}
+
+	if opts.Results != 1 {
+		t.Errorf("expected results to be reported to the caller, got %d", opts.Results)
+	}
+}
+
+func TestClient_createWithTags(t *testing.T) {
+	tags := map[string][]string{}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Tags []string `json:"tags"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+
+		tags[r.URL.Path] = body.Tags
+
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	ctx := context.Background()
+	tag := []string{"my-project"}
+
+	creates := map[string]func() error{
+		"/v4/volumes": func() error {
+			_, err := client.CreateVolume(ctx, VolumeCreateOptions{Label: "test", Region: "us-east", Tags: tag})
+			return err
+		},
+		"/v4/nodebalancers": func() error {
+			_, err := client.CreateNodeBalancer(ctx, NodeBalancerCreateOptions{Region: "us-east", Tags: tag})
+			return err
+		},
+		"/v4/domains": func() error {
+			_, err := client.CreateDomain(ctx, DomainCreateOptions{Domain: "example.com", Tags: tag})
+			return err
+		},
+		"/v4/networking/firewalls": func() error {
+			_, err := client.CreateFirewall(ctx, FirewallCreateOptions{Label: "test", Tags: tag})
+			return err
+		},
+		"/v4/images": func() error {
+			_, err := client.CreateImage(ctx, ImageCreateOptions{DiskID: 123, Label: "test", Tags: tag})
+			return err
+		},
+	}
+
+	for path, create := range creates {
+		if err := create(); err != nil {
+			t.Fatalf("%s: %s", path, err)
+		}
+
+		if len(tags[path]) != 1 || tags[path][0] != "my-project" {
+			t.Errorf("%s: expected tags to be sent, got %v", path, tags[path])
+		}
+	}
+}
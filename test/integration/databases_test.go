@@ -100,7 +100,7 @@ func waitForDatabaseUpdated(t *testing.T, client *linodego.Client, dbID int,
 	}
 
 	// Sometimes the event has finished but the status hasn't caught up
-	err = client.WaitForDatabaseStatus(context.Background(), dbID, dbType,
+	_, err = client.WaitForDatabaseStatus(context.Background(), dbID, dbType,
 		linodego.DatabaseStatusActive, 120)
 	if err != nil {
 		t.Fatalf("failed to wait for database active: %s", err)
@@ -122,14 +122,14 @@ func TestDatabase_Postgres_Suite(t *testing.T) {
 	}
 
 	// Wait for the DB to enter updating status
-	if err := client.WaitForDatabaseStatus(
+	if _, err := client.WaitForDatabaseStatus(
 		context.Background(), database.ID, linodego.DatabaseEngineTypePostgres,
 		linodego.DatabaseStatusUpdating, 240); err != nil {
 		t.Fatalf("failed to wait for database updating: %s", err)
 	}
 
 	// Wait for the DB to re-enter active status
-	if err := client.WaitForDatabaseStatus(
+	if _, err := client.WaitForDatabaseStatus(
 		context.Background(), database.ID, linodego.DatabaseEngineTypePostgres,
 		linodego.DatabaseStatusActive, 2400); err != nil {
 		t.Fatalf("failed to wait for database updating: %s", err)
@@ -164,7 +164,7 @@ func TestDatabase_Postgres_Suite(t *testing.T) {
 	}
 
 	// Wait for the DB to re-enter active status before final deletion
-	if err := client.WaitForDatabaseStatus(
+	if _, err := client.WaitForDatabaseStatus(
 		context.Background(), database.ID, linodego.DatabaseEngineTypePostgres,
 		linodego.DatabaseStatusActive, 2400); err != nil {
 		t.Fatalf("failed to wait for database updating: %s", err)
@@ -0,0 +1,173 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ErrClientClosed is returned for requests made after Client.Close has been called.
+var ErrClientClosed = errors.New("linodego: client is closed")
+
+// requestTracker keeps track of the closed state of a Client
+// and the number of requests currently in flight.
+type requestTracker struct {
+	lock     sync.Mutex
+	closed   bool
+	inFlight int
+	drained  chan struct{}
+}
+
+func newRequestTracker() *requestTracker {
+	return &requestTracker{drained: make(chan struct{})}
+}
+
+// start records a new in-flight request, or returns ErrClientClosed
+// if the tracker has already been closed.
+func (t *requestTracker) start() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.closed {
+		return ErrClientClosed
+	}
+
+	t.inFlight++
+
+	return nil
+}
+
+func (t *requestTracker) done() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.inFlight--
+	t.signalDrained()
+}
+
+// close marks the tracker as closed and returns a channel that
+// is closed once there are no requests in flight.
+func (t *requestTracker) close() <-chan struct{} {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.closed = true
+	t.signalDrained()
+
+	return t.drained
+}
+
+// signalDrained must be called with the lock held.
+func (t *requestTracker) signalDrained() {
+	if !t.closed || t.inFlight > 0 {
+		return
+	}
+
+	select {
+	case <-t.drained:
+	default:
+		close(t.drained)
+	}
+}
+
+// trackingTransport is an http.RoundTripper that records in-flight requests
+// with a requestTracker. A request is considered in flight until its response
+// body has been closed.
+type trackingTransport struct {
+	base    http.RoundTripper
+	tracker *requestTracker
+}
+
+func (t *trackingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.tracker.start(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.tracker.done()
+		return nil, err
+	}
+
+	resp.Body = &trackedBody{ReadCloser: resp.Body, tracker: t.tracker}
+
+	return resp, nil
+}
+
+// trackTransport wraps the given http.RoundTripper so its requests are
+// recorded by the Client's requestTracker.
+func (c *Client) trackTransport(transport http.RoundTripper) http.RoundTripper {
+	if tracking, ok := transport.(*trackingTransport); ok {
+		transport = tracking.base
+	}
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &trackingTransport{base: transport, tracker: c.requestTracker}
+}
+
+// withBaseTransport runs fn with the Client's untracked transport installed
+// so resty helpers that require an *http.Transport can be used. The tracking
+// wrapper is restored once fn returns.
+func (c *Client) withBaseTransport(fn func()) {
+	hc := c.resty.GetClient()
+
+	if tracking, ok := hc.Transport.(*trackingTransport); ok {
+		hc.Transport = tracking.base
+		defer func() {
+			hc.Transport = c.trackTransport(hc.Transport)
+		}()
+	}
+
+	fn()
+}
+
+// CloseIdleConnections closes the idle connections of the underlying transport, if supported.
+func (t *trackingTransport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+
+	if transport, ok := t.base.(closeIdler); ok {
+		transport.CloseIdleConnections()
+	}
+}
+
+type trackedBody struct {
+	io.ReadCloser
+
+	tracker *requestTracker
+	once    sync.Once
+}
+
+func (b *trackedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.tracker.done)
+
+	return err
+}
+
+// Close stops the Client from accepting new requests, waits for in-flight requests
+// to finish, and closes any idle connections. Requests made after Close has been
+// called will fail with ErrClientClosed.
+// If ctx expires before all in-flight requests have finished, idle connections are
+// still closed and the context's error is returned.
+func (c *Client) Close(ctx context.Context) error {
+	drained := c.requestTracker.close()
+
+	var err error
+
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	c.resty.GetClient().CloseIdleConnections()
+
+	return err
+}
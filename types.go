@@ -11,7 +11,7 @@ import (
 type LinodeType struct {
 	ID         string          `json:"id"`
 	Disk       int             `json:"disk"`
-	Class      LinodeTypeClass `json:"class"` // enum: nanode, standard, highmem, dedicated
+	Class      LinodeTypeClass `json:"class"` // enum: nanode, standard, highmem, dedicated, gpu, premium
 	Price      *LinodePrice    `json:"price"`
 	Label      string          `json:"label"`
 	Addons     *LinodeAddons   `json:"addons"`
@@ -19,6 +19,11 @@ type LinodeType struct {
 	Memory     int             `json:"memory"`
 	Transfer   int             `json:"transfer"`
 	VCPUs      int             `json:"vcpus"`
+	GPUs       int             `json:"gpus"`
+	Successor  *string         `json:"successor"`
+
+	// RegionPrices are the prices of the type in regions where they differ from Price
+	RegionPrices []LinodeRegionPrice `json:"region_prices"`
 }
 
 // LinodePrice represents a linode type price object
@@ -27,9 +32,40 @@ type LinodePrice struct {
 	Monthly float32 `json:"monthly"`
 }
 
+// PriceForRegion returns the price of the type in the given region,
+// which is the region's price override if there is one.
+func (t LinodeType) PriceForRegion(region string) *LinodePrice {
+	return regionPrice(t.Price, t.RegionPrices, region)
+}
+
+// LinodeRegionPrice represents the price of a type or addon in a specific region
+type LinodeRegionPrice struct {
+	ID      string  `json:"id"`
+	Hourly  float32 `json:"hourly"`
+	Monthly float32 `json:"monthly"`
+}
+
 // LinodeBackupsAddon represents a linode backups addon object
 type LinodeBackupsAddon struct {
-	Price *LinodePrice `json:"price"`
+	Price        *LinodePrice        `json:"price"`
+	RegionPrices []LinodeRegionPrice `json:"region_prices"`
+}
+
+// PriceForRegion returns the price of the backups addon in the given region,
+// which is the region's price override if there is one.
+func (a LinodeBackupsAddon) PriceForRegion(region string) *LinodePrice {
+	return regionPrice(a.Price, a.RegionPrices, region)
+}
+
+// regionPrice returns the price override for the region if there is one, and price otherwise
+func regionPrice(price *LinodePrice, regionPrices []LinodeRegionPrice, region string) *LinodePrice {
+	for _, regionPrice := range regionPrices {
+		if regionPrice.ID == region {
+			return &LinodePrice{Hourly: regionPrice.Hourly, Monthly: regionPrice.Monthly}
+		}
+	}
+
+	return price
 }
 
 // LinodeAddons represent the linode addons object
@@ -46,6 +82,8 @@ const (
 	ClassStandard  LinodeTypeClass = "standard"
 	ClassHighmem   LinodeTypeClass = "highmem"
 	ClassDedicated LinodeTypeClass = "dedicated"
+	ClassGPU       LinodeTypeClass = "gpu"
+	ClassPremium   LinodeTypeClass = "premium"
 )
 
 // LinodeTypesPagedResponse represents a linode types API response for listing
@@ -77,7 +115,7 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 		return nil, err
 	}
 
-	if result := c.getCachedResponse(endpoint); result != nil {
+	if result := c.getCachedListResponse(endpoint, opts); result != nil {
 		return result.([]LinodeType), nil
 	}
 
@@ -86,7 +124,7 @@ func (c *Client) ListTypes(ctx context.Context, opts *ListOptions) ([]LinodeType
 		return nil, err
 	}
 
-	c.addCachedResponse(endpoint, response.Data, &cacheExpiryTime)
+	c.addCachedListResponse(endpoint, response.Data, opts, &cacheExpiryTime)
 
 	return response.Data, nil
 }
@@ -110,3 +148,73 @@ func (c *Client) GetType(ctx context.Context, typeID string) (*LinodeType, error
 
 	return r.Result().(*LinodeType), nil
 }
+
+// GetTypeSuccessor follows the successor chain of the type with the provided ID and
+// returns the recommended current type. If the type has no successor, the type itself is returned.
+func (c *Client) GetTypeSuccessor(ctx context.Context, typeID string) (*LinodeType, error) {
+	visited := make(map[string]bool)
+	currentID := typeID
+
+	for {
+		if visited[currentID] {
+			return nil, fmt.Errorf("found a cycle in the successors of type %s at type %s", typeID, currentID)
+		}
+
+		visited[currentID] = true
+
+		linodeType, err := c.GetType(ctx, currentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if linodeType.Successor == nil || *linodeType.Successor == "" {
+			return linodeType, nil
+		}
+
+		currentID = *linodeType.Successor
+	}
+}
+
+// ListGPUTypes lists the linode types of the GPU class. This endpoint is cached by default.
+func (c *Client) ListGPUTypes(ctx context.Context) ([]LinodeType, error) {
+	f := Filter{}
+	f.AddField(Eq, "class", ClassGPU)
+
+	filter, err := f.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ListTypes(ctx, NewListOptions(0, string(filter)))
+}
+
+// ValidateTypeRegion checks that Linodes of the given type can be created in the given region,
+// based on the capabilities the region reports for the type's class (e.g. GPU Linodes).
+// It does not check whether the type is currently sold out; see RegionsForType.
+func (c *Client) ValidateTypeRegion(ctx context.Context, typeID, regionID string) error {
+	linodeType, err := c.GetType(ctx, typeID)
+	if err != nil {
+		return err
+	}
+
+	for _, capability := range linodeType.Class.requiredRegionCapabilities() {
+		if err := c.validateRegionCapability(ctx, regionID, capability); err != nil {
+			return fmt.Errorf("type %s cannot be created: %w", typeID, err)
+		}
+	}
+
+	return nil
+}
+
+// requiredRegionCapabilities returns the capabilities a region must report
+// for Linodes of the class to be created in it.
+func (class LinodeTypeClass) requiredRegionCapabilities() []string {
+	switch class {
+	case ClassGPU:
+		return []string{RegionCapabilityLinodes, RegionCapabilityGPU}
+	case ClassPremium:
+		return []string{RegionCapabilityLinodes, RegionCapabilityPremium}
+	default:
+		return []string{RegionCapabilityLinodes}
+	}
+}
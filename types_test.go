@@ -0,0 +1,90 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetTypeSuccessor(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/types/g5-standard-1": {http.StatusOK, `{"id": "g5-standard-1", "successor": "g6-standard-1"}`},
+		"/v4/linode/types/g6-standard-1": {http.StatusOK, `{"id": "g6-standard-1", "successor": "g7-standard-1"}`},
+		"/v4/linode/types/g7-standard-1": {http.StatusOK, `{"id": "g7-standard-1", "successor": null}`},
+	})
+	defer ts.Close()
+
+	successor, err := client.GetTypeSuccessor(context.Background(), "g5-standard-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if successor.ID != "g7-standard-1" {
+		t.Errorf("expected successor g7-standard-1 but got %s", successor.ID)
+	}
+}
+
+func TestClient_GetTypeSuccessor_cycle(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/types/g5-standard-1": {http.StatusOK, `{"id": "g5-standard-1", "successor": "g6-standard-1"}`},
+		"/v4/linode/types/g6-standard-1": {http.StatusOK, `{"id": "g6-standard-1", "successor": "g7-standard-1"}`},
+		"/v4/linode/types/g7-standard-1": {http.StatusOK, `{"id": "g7-standard-1", "successor": "g6-standard-1"}`},
+	})
+	defer ts.Close()
+
+	_, err := client.GetTypeSuccessor(context.Background(), "g5-standard-1")
+	if err == nil {
+		t.Fatal("expected an error for a cyclic successor chain")
+	}
+
+	if !strings.Contains(err.Error(), "type g5-standard-1") {
+		t.Errorf("expected error to name the requested type but got %q", err)
+	}
+}
+
+func TestClient_ListGPUTypes(t *testing.T) {
+	var filter string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		filter = r.Header.Get("X-Filter")
+		rw.Header().Add("Content-Type", "application/json")
+		rw.Write([]byte(`{"data": [{"id": "g1-gpu-rtx6000-1", "class": "gpu", "gpus": 1}], "page": 1, "pages": 1, "results": 1}`))
+	}))
+	defer ts.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(ts.URL)
+
+	types, err := client.ListGPUTypes(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"class":"gpu"}`; filter != expected {
+		t.Errorf("expected filter %s but got %s", expected, filter)
+	}
+
+	if len(types) != 1 || types[0].GPUs != 1 {
+		t.Errorf("unexpected types: %v", types)
+	}
+}
+
+func TestClient_ValidateTypeRegion(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/linode/types/g1-gpu-rtx6000-1": {http.StatusOK, `{"id": "g1-gpu-rtx6000-1", "class": "gpu"}`},
+		"/v4/regions/us-east":               {http.StatusOK, `{"id": "us-east", "capabilities": ["Linodes", "GPU Linodes"]}`},
+		"/v4/regions/us-west":               {http.StatusOK, `{"id": "us-west", "capabilities": ["Linodes"]}`},
+	})
+	defer ts.Close()
+
+	if err := client.ValidateTypeRegion(context.Background(), "g1-gpu-rtx6000-1", "us-east"); err != nil {
+		t.Errorf("expected us-east to support GPU Linodes, got %v", err)
+	}
+
+	err := client.ValidateTypeRegion(context.Background(), "g1-gpu-rtx6000-1", "us-west")
+	if err == nil || !strings.Contains(err.Error(), "does not support GPU Linodes") {
+		t.Errorf("expected us-west to not support GPU Linodes, got %v", err)
+	}
+}
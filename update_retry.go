@@ -0,0 +1,90 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultUpdateRetryAttempts is the number of attempts made by UpdateWithRetry
+// when maxAttempts is not positive.
+const DefaultUpdateRetryAttempts = 5
+
+// UpdateWithRetry performs a read-modify-write of a resource, retrying when the update
+// conflicts with a change made since the resource was read.
+// Each attempt reads the resource with get, applies mutate to it and writes it with update,
+// which is expected to be a conditional update such as UpdateFirewallRulesIfUnchanged.
+// An attempt is retried if update returns an error matching ErrConflict or ErrFirewallRulesChanged;
+// any other error, including one returned by mutate, is returned immediately.
+// If every attempt conflicts, the error of the last attempt is returned.
+func UpdateWithRetry[T any](
+	ctx context.Context,
+	maxAttempts int,
+	get func(ctx context.Context) (T, error),
+	mutate func(resource *T) error,
+	update func(ctx context.Context, resource T) (T, error),
+) (T, error) {
+	var zero T
+
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultUpdateRetryAttempts
+	}
+
+	var lastErr error
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		resource, err := get(ctx)
+		if err != nil {
+			return zero, err
+		}
+
+		if err := mutate(&resource); err != nil {
+			return zero, err
+		}
+
+		result, err := update(ctx, resource)
+		if err == nil {
+			return result, nil
+		}
+
+		if !isUpdateConflict(err) {
+			return zero, err
+		}
+
+		lastErr = err
+	}
+
+	return zero, fmt.Errorf("failed to update after %d attempts: %w", maxAttempts, lastErr)
+}
+
+// isUpdateConflict returns whether err indicates that a conditional update failed
+// because the resource was changed.
+func isUpdateConflict(err error) bool {
+	return errors.Is(err, ErrConflict) || errors.Is(err, ErrFirewallRulesChanged)
+}
+
+// UpdateFirewallRulesWithRetry applies mutate to the FirewallRuleSet for the given Firewall,
+// retrying with a fresh copy of the rules if they are changed before the update is made.
+func (c *Client) UpdateFirewallRulesWithRetry(
+	ctx context.Context,
+	firewallID int,
+	mutate func(rules *FirewallRuleSet) error,
+) (*FirewallRuleSet, error) {
+	return UpdateWithRetry(
+		ctx,
+		DefaultUpdateRetryAttempts,
+		func(ctx context.Context) (*FirewallRuleSet, error) {
+			return c.GetFirewallRules(ctx, firewallID)
+		},
+		func(rules **FirewallRuleSet) error {
+			return mutate(*rules)
+		},
+		func(ctx context.Context, rules *FirewallRuleSet) (*FirewallRuleSet, error) {
+			return c.UpdateFirewallRulesIfUnchanged(ctx, firewallID, *rules)
+		},
+	)
+}
@@ -0,0 +1,63 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestUpdateWithRetry(t *testing.T) {
+	version := 1
+	updates := 0
+
+	get := func(context.Context) (FirewallRuleSet, error) {
+		return FirewallRuleSet{InboundPolicy: "DROP", Version: version}, nil
+	}
+
+	mutate := func(rules *FirewallRuleSet) error {
+		rules.InboundPolicy = "ACCEPT"
+		return nil
+	}
+
+	update := func(_ context.Context, rules FirewallRuleSet) (FirewallRuleSet, error) {
+		updates++
+
+		// Simulate a concurrent change before the first two updates
+		if updates < 3 {
+			version++
+		}
+
+		if rules.Version != version {
+			return FirewallRuleSet{}, ErrFirewallRulesChanged
+		}
+
+		version++
+		rules.Version = version
+
+		return rules, nil
+	}
+
+	result, err := UpdateWithRetry(context.Background(), 0, get, mutate, update)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updates != 3 || result.InboundPolicy != "ACCEPT" || result.Version != 4 {
+		t.Errorf("unexpected result after %d updates: %+v", updates, result)
+	}
+
+	_, err = UpdateWithRetry(context.Background(), 2, get, mutate, func(context.Context, FirewallRuleSet) (FirewallRuleSet, error) {
+		return FirewallRuleSet{}, &Error{Code: 409, Message: "conflict"}
+	})
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("expected the last conflict to be returned, got %v", err)
+	}
+
+	mutateErr := errors.New("invalid rules")
+	updates = 0
+
+	_, err = UpdateWithRetry(context.Background(), 0, get, func(*FirewallRuleSet) error { return mutateErr }, update)
+	if !errors.Is(err, mutateErr) || updates != 0 {
+		t.Errorf("expected the mutate error to be returned without updating, got %v", err)
+	}
+}
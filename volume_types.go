@@ -0,0 +1,25 @@
+package linodego
+
+import (
+	"context"
+)
+
+// VolumeType represents the pricing of Volumes. The price is per GB of Volume size.
+type VolumeType struct {
+	ID           string              `json:"id"`
+	Label        string              `json:"label"`
+	Price        *LinodePrice        `json:"price"`
+	RegionPrices []LinodeRegionPrice `json:"region_prices"`
+	Transfer     int                 `json:"transfer"`
+}
+
+// PriceForRegion returns the price per GB of Volumes in the given region,
+// which is the region's price override if there is one.
+func (t VolumeType) PriceForRegion(region string) *LinodePrice {
+	return regionPrice(t.Price, t.RegionPrices, region)
+}
+
+// ListVolumeTypes lists the Volume types and their prices
+func (c *Client) ListVolumeTypes(ctx context.Context, opts *ListOptions) ([]VolumeType, error) {
+	return listPaginated[VolumeType](ctx, c, "volumes/types", opts)
+}
@@ -27,6 +27,16 @@ const (
 	VolumeContactSupport VolumeStatus = "contact_support"
 )
 
+// VolumeEncryption constants start with VolumeEncryption and include
+// Linode API volume encryption values
+type VolumeEncryption string
+
+// VolumeEncryption constants represent whether a Volume is encrypted
+const (
+	VolumeEncryptionEnabled  VolumeEncryption = "enabled"
+	VolumeEncryptionDisabled VolumeEncryption = "disabled"
+)
+
 // Volume represents a linode volume object
 type Volume struct {
 	ID             int          `json:"id"`
@@ -39,6 +49,8 @@ type Volume struct {
 	Tags           []string     `json:"tags"`
 	Created        *time.Time   `json:"-"`
 	Updated        *time.Time   `json:"-"`
+
+	Encryption VolumeEncryption `json:"encryption"`
 }
 
 // VolumeCreateOptions fields are those accepted by CreateVolume
@@ -52,6 +64,9 @@ type VolumeCreateOptions struct {
 	// An array of tags applied to this object. Tags are for organizational purposes only.
 	Tags               []string `json:"tags"`
 	PersistAcrossBoots *bool    `json:"persist_across_boots,omitempty"`
+
+	// Encryption is only supported in Regions with the Block Storage Encryption capability
+	Encryption VolumeEncryption `json:"encryption,omitempty"`
 }
 
 // VolumeUpdateOptions fields are those accepted by UpdateVolume
@@ -108,6 +123,7 @@ func (v Volume) GetCreateOptions() (createOpts VolumeCreateOptions) {
 	createOpts.Tags = v.Tags
 	createOpts.Region = v.Region
 	createOpts.Size = v.Size
+	createOpts.Encryption = v.Encryption
 	if v.LinodeID != nil && *v.LinodeID > 0 {
 		createOpts.LinodeID = *v.LinodeID
 	}
@@ -139,6 +155,17 @@ func (c *Client) ListVolumes(ctx context.Context, opts *ListOptions) ([]Volume,
 	return response.Data, nil
 }
 
+// ListVolumesByTag lists the Volumes that have been assigned the given tag
+func (c *Client) ListVolumesByTag(ctx context.Context, tag string, opts *ListOptions) ([]Volume, error) {
+	return listByTag(ctx, tag, opts, c.ListVolumes)
+}
+
+// GetVolumes gets the volumes with the provided IDs using as few requests as possible.
+// The results are in the order of ids, with nil entries for volumes that were not found.
+func (c *Client) GetVolumes(ctx context.Context, ids []int) ([]*Volume, error) {
+	return getByIDs(ctx, ids, c.ListVolumes, func(v Volume) int { return v.ID })
+}
+
 // GetVolume gets the template with the provided ID
 func (c *Client) GetVolume(ctx context.Context, volumeID int) (*Volume, error) {
 	e := fmt.Sprintf("volumes/%d", volumeID)
@@ -169,6 +196,18 @@ func (c *Client) AttachVolume(ctx context.Context, volumeID int, opts *VolumeAtt
 
 // CreateVolume creates a Linode Volume
 func (c *Client) CreateVolume(ctx context.Context, opts VolumeCreateOptions) (*Volume, error) {
+	if c.labelValidation {
+		if err := ValidateVolumeLabel(opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Encryption == VolumeEncryptionEnabled {
+		if err := c.validateVolumeEncryption(ctx, opts); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
@@ -184,8 +223,31 @@ func (c *Client) CreateVolume(ctx context.Context, opts VolumeCreateOptions) (*V
 	return resp.Result().(*Volume), nil
 }
 
+// validateVolumeEncryption checks that the Region the Volume is created in, or the Region
+// of the Linode it is attached to, supports encrypted Volumes.
+func (c *Client) validateVolumeEncryption(ctx context.Context, opts VolumeCreateOptions) error {
+	region := opts.Region
+
+	if region == "" && opts.LinodeID != 0 {
+		instance, err := c.GetInstance(ctx, opts.LinodeID)
+		if err != nil {
+			return err
+		}
+
+		region = instance.Region
+	}
+
+	return c.validateRegionCapability(ctx, region, RegionCapabilityBlockStorageEncryption)
+}
+
 // UpdateVolume updates the Volume with the specified id
 func (c *Client) UpdateVolume(ctx context.Context, volumeID int, opts VolumeUpdateOptions) (*Volume, error) {
+	if c.labelValidation && opts.Label != "" {
+		if err := ValidateVolumeLabel(opts.Label); err != nil {
+			return nil, err
+		}
+	}
+
 	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, NewError(err)
@@ -0,0 +1,49 @@
+package linodego
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestVolume_encryption(t *testing.T) {
+	var volume Volume
+	if err := json.Unmarshal([]byte(`{
+		"id": 123,
+		"filesystem_path": "/dev/disk/by-id/scsi-0Linode_Volume_data",
+		"encryption": "enabled"
+	}`), &volume); err != nil {
+		t.Fatal(err)
+	}
+
+	if volume.FilesystemPath != "/dev/disk/by-id/scsi-0Linode_Volume_data" || volume.Encryption != VolumeEncryptionEnabled {
+		t.Errorf("unexpected volume: %+v", volume)
+	}
+}
+
+func TestClient_CreateVolume_encryption(t *testing.T) {
+	ts, client := createRoutedTestServer(map[string]testResponse{
+		"/v4/regions/us-east":      {http.StatusOK, `{"id": "us-east", "capabilities": ["Block Storage", "Block Storage Encryption"]}`},
+		"/v4/regions/us-west":      {http.StatusOK, `{"id": "us-west", "capabilities": ["Block Storage"]}`},
+		"/v4/linode/instances/456": {http.StatusOK, `{"id": 456, "region": "us-west"}`},
+		"/v4/volumes":              {http.StatusOK, `{"id": 123, "encryption": "enabled"}`},
+	})
+	defer ts.Close()
+
+	if _, err := client.CreateVolume(context.Background(), VolumeCreateOptions{
+		Region:     "us-east",
+		Encryption: VolumeEncryptionEnabled,
+	}); err != nil {
+		t.Fatalf("expected us-east to support encryption, got %v", err)
+	}
+
+	_, err := client.CreateVolume(context.Background(), VolumeCreateOptions{
+		LinodeID:   456,
+		Encryption: VolumeEncryptionEnabled,
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not support Block Storage Encryption") {
+		t.Fatalf("expected the region of linode 456 to not support encryption, got %v", err)
+	}
+}
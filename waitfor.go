@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/linode/linodego/internal/parseabletime"
 )
 
 type EventPoller struct {
@@ -17,57 +19,90 @@ type EventPoller struct {
 
 	client         Client
 	previousEvents map[int]bool
+
+	// The watermark loaded from the Client's WatermarkStore
+	watermarkLoaded bool
+	watermarkID     int
 }
 
 // WaitForInstanceStatus waits for the Linode instance to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed Instance is returned along with the error.
 func (client Client) WaitForInstanceStatus(ctx context.Context, instanceID int, status InstanceStatus, timeoutSeconds int) (*Instance, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	return client.WaitForInstanceStatusWithProgress(ctx, instanceID, status, timeoutSeconds, nil)
+}
+
+// WaitForInstanceStatusWithProgress waits for the Linode instance to reach the desired state
+// before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed Instance is returned along with the error.
+// If onPoll is not nil, it is called with the observed status of the instance after each poll.
+func (client Client) WaitForInstanceStatusWithProgress(
+	ctx context.Context,
+	instanceID int,
+	status InstanceStatus,
+	timeoutSeconds int,
+	onPoll func(current InstanceStatus),
+) (*Instance, error) {
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last *Instance
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			instance, err := client.GetInstance(ctx, instanceID)
 			if err != nil {
-				return instance, err
+				return last, err
+			}
+
+			last = instance
+
+			if onPoll != nil {
+				onPoll(instance.Status)
 			}
+
 			complete := (instance.Status == status)
 
 			if complete {
 				return instance, nil
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, ctx.Err())
+			return last, fmt.Errorf("Error waiting for Instance %d status %s: %w", instanceID, status, ctx.Err())
 		}
 	}
 }
 
 // WaitForInstanceDiskStatus waits for the Linode instance disk to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed InstanceDisk is returned along with the error.
 func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID int, diskID int, status DiskStatus, timeoutSeconds int) (*InstanceDisk, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last *InstanceDisk
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			// GetInstanceDisk will 404 on newly created disks. use List instead.
 			// disk, err := client.GetInstanceDisk(ctx, instanceID, diskID)
 			disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
 			if err != nil {
-				return nil, err
+				return last, err
 			}
 
 			for _, disk := range disks {
 				disk := disk
 				if disk.ID == diskID {
+					last = &disk
+
 					complete := (disk.Status == status)
 					if complete {
 						return &disk, nil
@@ -77,61 +112,118 @@ func (client Client) WaitForInstanceDiskStatus(ctx context.Context, instanceID i
 				}
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, ctx.Err())
+			return last, fmt.Errorf("Error waiting for Instance %d Disk %d status %s: %w", instanceID, diskID, status, ctx.Err())
+		}
+	}
+}
+
+// WaitForInstanceReady waits for the Linode instance to be running and for all of its disks
+// to be ready before returning, such as after a rebuild or clone, when the instance may be
+// running before its disks have finished being imaged. It will timeout with an error after
+// timeoutSeconds, which is shared by the waits for the instance and its disks.
+// If the wait fails, the last observed Instance is returned along with the error.
+func (client Client) WaitForInstanceReady(ctx context.Context, instanceID int, timeoutSeconds int) (*Instance, error) {
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	instance, err := client.WaitForInstanceStatus(ctx, instanceID, InstanceRunning, timeoutSeconds)
+	if err != nil {
+		return instance, err
+	}
+
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	defer ticker.Stop()
+
+	var notReady []int
+
+	for {
+		disks, err := client.ListInstanceDisks(ctx, instanceID, nil)
+		if err != nil {
+			return instance, err
+		}
+
+		notReady = notReady[:0]
+
+		for _, disk := range disks {
+			if disk.Status != DiskReady {
+				notReady = append(notReady, disk.ID)
+			}
+		}
+
+		if len(notReady) == 0 {
+			return instance, nil
+		}
+
+		select {
+		case <-ticker.C():
+		case <-ctx.Done():
+			return instance, fmt.Errorf("Error waiting for Instance %d disks %v to be ready: %w", instanceID, notReady, ctx.Err())
 		}
 	}
 }
 
 // WaitForVolumeStatus waits for the Volume to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed Volume is returned along with the error.
 func (client Client) WaitForVolumeStatus(ctx context.Context, volumeID int, status VolumeStatus, timeoutSeconds int) (*Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last *Volume
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			volume, err := client.GetVolume(ctx, volumeID)
 			if err != nil {
-				return volume, err
+				return last, err
 			}
+
+			last = volume
+
 			complete := (volume.Status == status)
 
 			if complete {
 				return volume, nil
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, ctx.Err())
+			return last, fmt.Errorf("Error waiting for Volume %d status %s: %w", volumeID, status, ctx.Err())
 		}
 	}
 }
 
 // WaitForSnapshotStatus waits for the Snapshot to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed InstanceSnapshot is returned along with the error.
 func (client Client) WaitForSnapshotStatus(ctx context.Context, instanceID int, snapshotID int, status InstanceSnapshotStatus, timeoutSeconds int) (*InstanceSnapshot, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last *InstanceSnapshot
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			snapshot, err := client.GetInstanceSnapshot(ctx, instanceID, snapshotID)
 			if err != nil {
-				return snapshot, err
+				return last, err
 			}
+
+			last = snapshot
+
 			complete := (snapshot.Status == status)
 
 			if complete {
 				return snapshot, nil
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, ctx.Err())
+			return last, fmt.Errorf("Error waiting for Instance %d Snapshot %d status %s: %w", instanceID, snapshotID, status, ctx.Err())
 		}
 	}
 }
@@ -140,21 +232,26 @@ func (client Client) WaitForSnapshotStatus(ctx context.Context, instanceID int,
 // before returning. An active Instance will not immediately attach or detach a volume, so
 // the LinodeID must be polled to determine volume readiness from the API.
 // WaitForVolumeLinodeID will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed Volume is returned along with the error.
 func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, linodeID *int, timeoutSeconds int) (*Volume, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last *Volume
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			volume, err := client.GetVolume(ctx, volumeID)
 			if err != nil {
-				return volume, err
+				return last, err
 			}
 
+			last = volume
+
 			switch {
 			case linodeID == nil && volume.LinodeID == nil:
 				return volume, nil
@@ -164,34 +261,71 @@ func (client Client) WaitForVolumeLinodeID(ctx context.Context, volumeID int, li
 				return volume, nil
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, ctx.Err())
+			return last, fmt.Errorf("Error waiting for Volume %d to have Instance %v: %w", volumeID, linodeID, ctx.Err())
 		}
 	}
 }
 
 // WaitForLKEClusterStatus waits for the LKECluster to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed LKECluster is returned along with the error.
 func (client Client) WaitForLKEClusterStatus(ctx context.Context, clusterID int, status LKEClusterStatus, timeoutSeconds int) (*LKECluster, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last *LKECluster
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			cluster, err := client.GetLKECluster(ctx, clusterID)
 			if err != nil {
-				return cluster, err
+				return last, err
 			}
+
+			last = cluster
+
 			complete := (cluster.Status == status)
 
 			if complete {
 				return cluster, nil
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Cluster %d status %s: %w", clusterID, status, ctx.Err())
+			return last, fmt.Errorf("Error waiting for Cluster %d status %s: %w", clusterID, status, ctx.Err())
+		}
+	}
+}
+
+// WaitForLKENodePoolReady waits for every node in the LKENodePool to report ready
+// before returning. It will timeout with an error after timeoutSeconds.
+// On error, the last Node Pool that was observed is returned, if any.
+func (client Client) WaitForLKENodePoolReady(ctx context.Context, clusterID, poolID int, timeoutSeconds int) (*LKENodePool, error) {
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	defer ticker.Stop()
+
+	var last *LKENodePool
+
+	for {
+		select {
+		case <-ticker.C():
+			pool, err := client.GetLKENodePool(ctx, clusterID, poolID)
+			if err != nil {
+				return last, err
+			}
+
+			last = pool
+
+			if pool.IsReady() {
+				return pool, nil
+			}
+		case <-ctx.Done():
+			return last, fmt.Errorf("failed to wait for LKE Cluster %d Node Pool %d to be ready: %w", clusterID, poolID, ctx.Err())
 		}
 	}
 }
@@ -228,7 +362,7 @@ func (client Client) WaitForLKEClusterConditions(
 ) error {
 	ctx, cancel := context.WithCancel(ctx)
 	if options.TimeoutSeconds != 0 {
-		ctx, cancel = context.WithTimeout(ctx, time.Duration(options.TimeoutSeconds)*time.Second)
+		ctx, cancel = client.withTimeout(ctx, time.Duration(options.TimeoutSeconds)*time.Second)
 	}
 	defer cancel()
 
@@ -237,7 +371,7 @@ func (client Client) WaitForLKEClusterConditions(
 		return fmt.Errorf("failed to get Kubeconfig for LKE cluster %d: %w", clusterID, err)
 	}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
 	conditionOptions := ClusterConditionOptions{LKEClusterKubeconfig: lkeKubeConfig, TransportWrapper: options.TransportWrapper}
@@ -246,7 +380,7 @@ func (client Client) WaitForLKEClusterConditions(
 	ConditionSucceeded:
 		for {
 			select {
-			case <-ticker.C:
+			case <-ticker.C():
 				result, err := condition(ctx, conditionOptions)
 				if err != nil {
 					log.Printf("[WARN] Ignoring WaitForLKEClusterConditions conditional error: %s", err)
@@ -269,6 +403,7 @@ func (client Client) WaitForLKEClusterConditions(
 
 // WaitForEventFinished waits for an entity action to reach the 'finished' state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed matching Event is returned along with the error.
 // If the event indicates a failure both the failed event and the error will be returned.
 //nolint
 func (client Client) WaitForEventFinished(
@@ -285,7 +420,7 @@ func (client Client) WaitForEventFinished(
 		OrderBy: "created",
 	}
 	filter.AddField(Eq, "action", action)
-	filter.AddField(Gte, "created", minStart.UTC().Format("2006-01-02T15:04:05"))
+	filter.AddField(Gte, "created", parseabletime.Format(minStart))
 
 	// Optimistically restrict results to page 1.  We should remove this when more
 	// precise filtering options exist.
@@ -305,25 +440,27 @@ func (client Client) WaitForEventFinished(
 		filter.AddField(Eq, "entity.type", entityType)
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
 	if deadline, ok := ctx.Deadline(); ok {
-		duration := time.Until(deadline)
+		duration := deadline.Sub(client.getClock().Now())
 		log.Printf("[INFO] Waiting %d seconds for %s events since %v for %s %v", int(duration.Seconds()), action, minStart, titledEntityType, id)
 	}
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 
 	// avoid repeating log messages
 	nextLog := ""
 	lastLog := ""
 	lastEventID := 0
 
+	var lastEvent *Event
+
 	defer ticker.Stop()
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			if lastEventID > 0 {
 				filter.AddField(Gte, "id", lastEventID)
 			}
@@ -337,7 +474,7 @@ func (client Client) WaitForEventFinished(
 
 			events, err := client.ListEvents(ctx, listOptions)
 			if err != nil {
-				return nil, err
+				return lastEvent, err
 			}
 
 			// If there are events for this instance + action, inspect them
@@ -384,6 +521,8 @@ func (client Client) WaitForEventFinished(
 					lastEventID = event.ID
 				}
 
+				lastEvent = &event
+
 				switch event.Status {
 				case EventFailed:
 					return &event, fmt.Errorf("%s %v action %s failed", titledEntityType, id, action)
@@ -401,49 +540,55 @@ func (client Client) WaitForEventFinished(
 				lastLog = nextLog
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, ctx.Err())
+			return lastEvent, fmt.Errorf("Error waiting for Event Status '%s' of %s %v action '%s': %w", EventFinished, titledEntityType, id, action, ctx.Err())
 		}
 	}
 }
 
 // WaitForImageStatus waits for the Image to reach the desired state
 // before returning. It will timeout with an error after timeoutSeconds.
+// If the wait fails, the last observed Image is returned along with the error.
 func (client Client) WaitForImageStatus(ctx context.Context, imageID string, status ImageStatus, timeoutSeconds int) (*Image, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last *Image
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			image, err := client.GetImage(ctx, imageID)
 			if err != nil {
-				return image, err
+				return last, err
 			}
+
+			last = image
+
 			complete := image.Status == status
 
 			if complete {
 				return image, nil
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
+			return last, fmt.Errorf("failed to wait for Image %s status %s: %w", imageID, status, ctx.Err())
 		}
 	}
 }
 
 // WaitForMySQLDatabaseBackup waits for the backup with the given label to be available.
 func (client Client) WaitForMySQLDatabaseBackup(ctx context.Context, dbID int, label string, timeoutSeconds int) (*MySQLDatabaseBackup, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			backups, err := client.ListMySQLDatabaseBackups(ctx, dbID, nil)
 			if err != nil {
 				return nil, err
@@ -462,15 +607,15 @@ func (client Client) WaitForMySQLDatabaseBackup(ctx context.Context, dbID int, l
 
 // WaitForPostgresDatabaseBackup waits for the backup with the given label to be available.
 func (client Client) WaitForPostgresDatabaseBackup(ctx context.Context, dbID int, label string, timeoutSeconds int) (*PostgresDatabaseBackup, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			backups, err := client.ListPostgresDatabaseBackups(ctx, dbID, nil)
 			if err != nil {
 				return nil, err
@@ -509,33 +654,39 @@ var databaseStatusHandlers = map[DatabaseEngineType]databaseStatusFunc{
 }
 
 // WaitForDatabaseStatus waits for the provided database to have the given status.
+// The last status that was observed is returned, including on error, where it is
+// empty if the status was never fetched.
 func (client Client) WaitForDatabaseStatus(
 	ctx context.Context, dbID int, dbEngine DatabaseEngineType, status DatabaseStatus, timeoutSeconds int,
-) error {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+) (DatabaseStatus, error) {
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
+	var last DatabaseStatus
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			statusHandler, ok := databaseStatusHandlers[dbEngine]
 			if !ok {
-				return fmt.Errorf("invalid db engine: %s", dbEngine)
+				return last, fmt.Errorf("invalid db engine: %s", dbEngine)
 			}
 
 			currentStatus, err := statusHandler(ctx, client, dbID)
 			if err != nil {
-				return fmt.Errorf("failed to get db status: %w", err)
+				return last, fmt.Errorf("failed to get db status: %w", err)
 			}
 
+			last = currentStatus
+
 			if currentStatus == status {
-				return nil
+				return currentStatus, nil
 			}
 		case <-ctx.Done():
-			return fmt.Errorf("failed to wait for database %d status: %w", dbID, ctx.Err())
+			return last, fmt.Errorf("failed to wait for database %d status: %w", dbID, ctx.Err())
 		}
 	}
 }
@@ -611,11 +762,11 @@ func (p *EventPoller) PreTask(ctx context.Context) error {
 
 	p.previousEvents = eventIDs
 
-	return nil
+	return p.loadWatermark(ctx)
 }
 
 func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, error) {
-	ticker := time.NewTicker(p.client.millisecondsPerPoll * time.Millisecond)
+	ticker := p.client.getClock().NewTicker(p.client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
 	f := Filter{
@@ -636,20 +787,33 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 		PageOptions: &PageOptions{Page: 1},
 	}
 
+	if err := p.loadWatermark(ctx); err != nil {
+		return nil, err
+	}
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			events, err := p.client.ListEvents(ctx, &listOpts)
 			if err != nil {
 				return nil, fmt.Errorf("failed to list events: %w", err)
 			}
 
 			for _, event := range events {
+				// Skip events seen by a previous poller
+				if event.ID <= p.watermarkID {
+					continue
+				}
+
 				if _, ok := p.previousEvents[event.ID]; !ok {
 					// Store this event so it is no longer picked up
 					// on subsequent jobs
 					p.previousEvents[event.ID] = true
 
+					if err := p.advanceWatermark(ctx, event.ID); err != nil {
+						return nil, err
+					}
+
 					return &event, nil
 				}
 			}
@@ -660,13 +824,24 @@ func (p *EventPoller) WaitForLatestUnknownEvent(ctx context.Context) (*Event, er
 }
 
 // WaitForFinished waits for a new event to be finished.
+// If the wait fails, the last observed Event is returned along with the error.
 func (p *EventPoller) WaitForFinished(
 	ctx context.Context, timeoutSeconds int,
 ) (*Event, error) {
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	return p.WaitForFinishedWithProgress(ctx, timeoutSeconds, nil)
+}
+
+// WaitForFinishedWithProgress waits for a new event to be finished.
+// If the wait fails, the last observed Event is returned along with the error.
+// If onPoll is not nil, it is called with the observed Event after each poll, so that
+// progress can be reported from its PercentComplete and TimeRemaining.
+func (p *EventPoller) WaitForFinishedWithProgress(
+	ctx context.Context, timeoutSeconds int, onPoll func(current *Event),
+) (*Event, error) {
+	ctx, cancel := p.client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(p.client.millisecondsPerPoll * time.Millisecond)
+	ticker := p.client.getClock().NewTicker(p.client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
 	event, err := p.WaitForLatestUnknownEvent(ctx)
@@ -676,22 +851,28 @@ func (p *EventPoller) WaitForFinished(
 
 	for {
 		select {
-		case <-ticker.C:
-			event, err := p.client.GetEvent(ctx, event.ID)
+		case <-ticker.C():
+			current, err := p.client.GetEvent(ctx, event.ID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get event: %w", err)
+				return event, fmt.Errorf("failed to get event: %w", err)
+			}
+
+			event = current
+
+			if onPoll != nil {
+				onPoll(event)
 			}
 
 			switch event.Status {
 			case EventFinished:
 				return event, nil
 			case EventFailed:
-				return nil, fmt.Errorf("event %d has failed", event.ID)
+				return event, fmt.Errorf("event %d has failed", event.ID)
 			case EventScheduled, EventStarted, EventNotification:
 				continue
 			}
 		case <-ctx.Done():
-			return nil, fmt.Errorf("failed to wait for event: %w", ctx.Err())
+			return event, fmt.Errorf("failed to wait for event: %w", ctx.Err())
 		}
 	}
 }
@@ -709,13 +890,13 @@ func (client Client) WaitForResourceFree(
 
 	filterStr, err := apiFilter.MarshalJSON()
 	if err != nil {
-		return fmt.Errorf("failed to create filter: %s", err)
+		return fmt.Errorf("failed to create filter: %w", err)
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	ctx, cancel := client.withTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
 	defer cancel()
 
-	ticker := time.NewTicker(client.millisecondsPerPoll * time.Millisecond)
+	ticker := client.getClock().NewTicker(client.millisecondsPerPoll * time.Millisecond)
 	defer ticker.Stop()
 
 	// A helper function to determine whether a resource is busy
@@ -731,12 +912,12 @@ func (client Client) WaitForResourceFree(
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			events, err := client.ListEvents(ctx, &ListOptions{
 				Filter: string(filterStr),
 			})
 			if err != nil {
-				return fmt.Errorf("failed to list events: %s", err)
+				return fmt.Errorf("failed to list events: %w", err)
 			}
 
 			if !checkIsBusy(events) {
@@ -744,7 +925,7 @@ func (client Client) WaitForResourceFree(
 			}
 
 		case <-ctx.Done():
-			return fmt.Errorf("failed to wait for resource free: %s", ctx.Err())
+			return fmt.Errorf("failed to wait for resource free: %w", ctx.Err())
 		}
 	}
 }